@@ -1,10 +1,13 @@
 package assert
 
 import (
+	"errors"
 	"fmt"
 	"math"
 	"reflect"
+	"sort"
 	"strings"
+	"sync"
 	"time"
 )
 
@@ -13,37 +16,103 @@ type TestingT interface {
 	Errorf(format string, args ...interface{})
 }
 
+// tHelper is implemented by TestingT values, like *testing.T, that can mark the
+// calling function as a test helper so that failures are reported at the line
+// in the test that called it.
+type tHelper interface {
+	Helper()
+}
+
+// failNower is implemented by TestingT values, like *testing.T, that can stop
+// the running test.
+type failNower interface {
+	FailNow()
+}
+
 // Comparison a custom function that returns true on success and false on failure
 type Comparison func() (success bool)
 
+// KV is a set of keys and values that can be passed as the last of an
+// assertion's msgAndArgs, to be shown as a table under Messages when it fails.
+//
+//    assert.Equal(t, 200, resp.StatusCode, "unexpected status", assert.KV{
+//      "url":  req.URL,
+//      "body": string(body),
+//    })
+type KV map[string]interface{}
+
 // Fail reports a failure through
 func Fail(t TestingT, failureMessage string, msgAndArgs ...interface{}) bool {
-	message := messageFromMsgAndArgs(msgAndArgs...)
+	msgAndArgs, table := splitKV(msgAndArgs)
+	message := contextMessage(t, messageFromMsgAndArgs(msgAndArgs...))
+	if len(table) > 0 {
+		message = strings.TrimPrefix(message+"\n"+formatKV(table), "\n")
+	}
+
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+
+		output := "\n\tError Trace:\t" + strings.Join(callerInfo(), "\n\t\t\t") +
+			"\n\tError:" + indentMessageLines(failureMessage, 2) +
+			formatNotes(t, "\n\t")
+		if len(message) > 0 {
+			output += "\n\tMessages:\t" + strings.ReplaceAll(message, "\n", "\n\t\t\t")
+		}
+
+		t.Errorf("%s\n", output)
+		return false
+	}
 
 	errorTrace := strings.Join(callerInfo(), "\n\r\t\t\t")
+	notes := formatNotes(t, "\n\r\t")
 	if len(message) > 0 {
 		t.Errorf("\r%s\r\tError Trace:\t%s\n"+
-			"\r\tError:%s\n"+
+			"\r\tError:%s%s\n"+
 			"\r\tMessages:\t%s\n\r",
 			getWhitespaceString(),
 			errorTrace,
 			indentMessageLines(failureMessage, 2),
-			message)
+			notes,
+			strings.ReplaceAll(message, "\n", "\n\r\t\t\t"))
 	} else {
 		t.Errorf("\r%s\r\tError Trace:\t%s\n"+
-			"\r\tError:%s\n\r",
+			"\r\tError:%s%s\n\r",
 			getWhitespaceString(),
 			errorTrace,
-			indentMessageLines(failureMessage, 2))
+			indentMessageLines(failureMessage, 2),
+			notes)
 	}
 
 	return false
 }
 
+// failMisuse reports that an assertion could not be checked, for example
+// because it was given a value of the wrong type. Unlike other failures these
+// are not discarded when the assertion is negated by Wrapped.Not, as negating it
+// doesn't make the values any more valid.
+func failMisuse(t TestingT, failureMessage string, msgAndArgs ...interface{}) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+
+	if r, ok := reporterOf(t); ok && r.discard {
+		r.misused = true
+		t = withReporter(t, func(r *reporter) {
+			r.discard = false
+		})
+	}
+
+	return Fail(t, failureMessage, msgAndArgs...)
+}
+
 // Implements asserts that an object is implemented by the specified interface.
 //
 //    assert.Implements(t, (*MyInterface)(nil), new(MyObject), "MyObject")
 func Implements(t TestingT, interfaceObject interface{}, object interface{}, msgAndArgs ...interface{}) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+
 	interfaceType := reflect.TypeOf(interfaceObject).Elem()
 
 	if !reflect.TypeOf(object).Implements(interfaceType) {
@@ -56,6 +125,10 @@ func Implements(t TestingT, interfaceObject interface{}, object interface{}, msg
 
 // IsType asserts that the specified objects are of the same type.
 func IsType(t TestingT, expectedType interface{}, object interface{}, msgAndArgs ...interface{}) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+
 	if !objectsAreEqual(reflect.TypeOf(object), reflect.TypeOf(expectedType)) {
 		return Fail(t, fmt.Sprintf("Object expected to be of type %v, but was %v", reflect.TypeOf(expectedType), reflect.TypeOf(object)), msgAndArgs...)
 	}
@@ -69,9 +142,12 @@ func IsType(t TestingT, expectedType interface{}, object interface{}, msgAndArgs
 //
 // Returns whether the assertion was successful (true) or not (false).
 func Equal(t TestingT, expected, actual interface{}, msgAndArgs ...interface{}) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+
 	if !objectsAreEqual(expected, actual) {
-		return Fail(t, fmt.Sprintf("Not equal: %#v (expected)\n"+
-			"        != %#v (actual)", expected, actual), msgAndArgs...)
+		return Fail(t, equalFailureMessage(expected, actual), msgAndArgs...)
 	}
 
 	return true
@@ -84,9 +160,12 @@ func Equal(t TestingT, expected, actual interface{}, msgAndArgs ...interface{})
 //
 // Returns whether the assertion was successful (true) or not (false).
 func Equivalent(t TestingT, expected, actual interface{}, msgAndArgs ...interface{}) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+
 	if !objectsAreEquivalent(expected, actual) {
-		return Fail(t, fmt.Sprintf("Not equal: %#v (expected)\n"+
-			"        != %#v (actual)", expected, actual), msgAndArgs...)
+		return Fail(t, equivalentFailureMessage(expected, actual), msgAndArgs...)
 	}
 
 	return true
@@ -98,6 +177,10 @@ func Equivalent(t TestingT, expected, actual interface{}, msgAndArgs ...interfac
 //
 // Returns whether the assertion was successful (true) or not (false).
 func Exactly(t TestingT, expected, actual interface{}, msgAndArgs ...interface{}) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+
 	aType := reflect.TypeOf(expected)
 	bType := reflect.TypeOf(actual)
 
@@ -108,12 +191,45 @@ func Exactly(t TestingT, expected, actual interface{}, msgAndArgs ...interface{}
 	return Equal(t, expected, actual, msgAndArgs...)
 }
 
+// EqualWith asserts that two objects are equal, using the EqualOption values in
+// msgAndArgs to control how they are compared. The other values in msgAndArgs
+// are used as the message, as for any other assertion.
+//
+//    assert.EqualWith(t, expected, actual, assert.IgnoreFields("CreatedAt"), assert.EquateEmpty())
+//    assert.EqualWith(t, expected, actual, assert.EquateEmpty(), "user %d", id)
+//
+// Returns whether the assertion was successful (true) or not (false).
+func EqualWith(t TestingT, expected, actual interface{}, msgAndArgs ...interface{}) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+
+	opts, msgAndArgs := splitEqualOptions(msgAndArgs)
+
+	if expected == nil || actual == nil {
+		if expected != actual {
+			return Fail(t, equalFailureMessage(expected, actual), msgAndArgs...)
+		}
+		return true
+	}
+
+	if !newDiffer(false, opts...).walk("", reflect.ValueOf(expected), reflect.ValueOf(actual)) {
+		return Fail(t, equalFailureMessage(expected, actual, opts...), msgAndArgs...)
+	}
+
+	return true
+}
+
 // NotNil asserts that the specified object is not nil.
 //
 //    assert.NotNil(t, err, "err should be something")
 //
 // Returns whether the assertion was successful (true) or not (false).
 func NotNil(t TestingT, object interface{}, msgAndArgs ...interface{}) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+
 	success := true
 
 	if object == nil {
@@ -139,6 +255,10 @@ func NotNil(t TestingT, object interface{}, msgAndArgs ...interface{}) bool {
 //
 // Returns whether the assertion was successful (true) or not (false).
 func Nil(t TestingT, object interface{}, msgAndArgs ...interface{}) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+
 	if isNil(object) {
 		return true
 	}
@@ -146,6 +266,165 @@ func Nil(t TestingT, object interface{}, msgAndArgs ...interface{}) bool {
 	return Fail(t, fmt.Sprintf("Expected nil, but got: %#v", object), msgAndArgs...)
 }
 
+// Error asserts that a function returned an error (i.e. not nil).
+//
+//    actualObj, err := SomeFunction()
+//    assert.Error(t, err, "An error was expected")
+//
+// Returns whether the assertion was successful (true) or not (false).
+func Error(t TestingT, err error, msgAndArgs ...interface{}) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+
+	if err == nil {
+		return Fail(t, "An error is expected but got nil.", msgAndArgs...)
+	}
+
+	return true
+}
+
+// NoError asserts that a function returned no error (i.e. nil).
+//
+//    actualObj, err := SomeFunction()
+//    if assert.NoError(t, err) {
+//      assert.Equal(t, expectedObj, actualObj)
+//    }
+//
+// Returns whether the assertion was successful (true) or not (false).
+func NoError(t TestingT, err error, msgAndArgs ...interface{}) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+
+	if err != nil {
+		return Fail(t, "Received unexpected error:\n"+errorChain(err), msgAndArgs...)
+	}
+
+	return true
+}
+
+// ErrorIs asserts that at least one of the errors in err's chain matches
+// target, using errors.Is.
+//
+//    assert.ErrorIs(t, err, fs.ErrNotExist)
+//
+// Returns whether the assertion was successful (true) or not (false).
+func ErrorIs(t TestingT, err, target error, msgAndArgs ...interface{}) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+
+	if !errors.Is(err, target) {
+		return Fail(t, fmt.Sprintf("Target error should be in err chain:\n"+
+			"expected: %s\n"+
+			"in chain:\n%s", errorChain(target), errorChain(err)), msgAndArgs...)
+	}
+
+	return true
+}
+
+// NotErrorIs asserts that none of the errors in err's chain match target,
+// using errors.Is.
+//
+//    assert.NotErrorIs(t, err, fs.ErrNotExist)
+//
+// Returns whether the assertion was successful (true) or not (false).
+func NotErrorIs(t TestingT, err, target error, msgAndArgs ...interface{}) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+
+	if errors.Is(err, target) {
+		return Fail(t, fmt.Sprintf("Target error should not be in err chain:\n"+
+			"found: %s\n"+
+			"in chain:\n%s", errorChain(target), errorChain(err)), msgAndArgs...)
+	}
+
+	return true
+}
+
+// ErrorAs asserts that at least one of the errors in err's chain matches
+// target, using errors.As, and if so sets target to that error.
+//
+//    var pathErr *fs.PathError
+//    assert.ErrorAs(t, err, &pathErr)
+//
+// Returns whether the assertion was successful (true) or not (false).
+func ErrorAs(t TestingT, err error, target interface{}, msgAndArgs ...interface{}) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+
+	targetValue := reflect.ValueOf(target)
+	if target == nil || targetValue.Kind() != reflect.Ptr || targetValue.IsNil() {
+		return failMisuse(t, fmt.Sprintf("Target must be a non-nil pointer, but was %#v", target), msgAndArgs...)
+	}
+
+	targetType := targetValue.Type().Elem()
+	if targetType.Kind() != reflect.Interface && !targetType.Implements(reflect.TypeOf((*error)(nil)).Elem()) {
+		return failMisuse(t, fmt.Sprintf("Target must point to an interface or a type implementing error, but was %v", targetValue.Type()), msgAndArgs...)
+	}
+
+	if !errors.As(err, target) {
+		return Fail(t, fmt.Sprintf("Should be in err chain:\n"+
+			"expected: %v\n"+
+			"in chain:\n%s", targetType, errorChain(err)), msgAndArgs...)
+	}
+
+	return true
+}
+
+// EqualError asserts that a function returned an error (i.e. not nil) and that
+// it is equal to the provided error message.
+//
+//    actualObj, err := SomeFunction()
+//    assert.EqualError(t, err, expectedErrorString, "An error was expected")
+//
+// Returns whether the assertion was successful (true) or not (false).
+func EqualError(t TestingT, theError error, errString string, msgAndArgs ...interface{}) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+
+	if theError == nil {
+		return Fail(t, fmt.Sprintf("An error with message %q is expected but got nil.", errString), msgAndArgs...)
+	}
+
+	if theError.Error() != errString {
+		return Fail(t, fmt.Sprintf("Error message not equal:\n"+
+			"expected: %q\n"+
+			"actual:   %q\n"+
+			"in chain:\n%s", errString, theError.Error(), errorChain(theError)), msgAndArgs...)
+	}
+
+	return true
+}
+
+// ErrorContains asserts that a function returned an error (i.e. not nil) and
+// that its message contains the specified substring.
+//
+//    actualObj, err := SomeFunction()
+//    assert.ErrorContains(t, err, "not found")
+//
+// Returns whether the assertion was successful (true) or not (false).
+func ErrorContains(t TestingT, theError error, contains string, msgAndArgs ...interface{}) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+
+	if theError == nil {
+		return Fail(t, fmt.Sprintf("An error containing %q is expected but got nil.", contains), msgAndArgs...)
+	}
+
+	if !strings.Contains(theError.Error(), contains) {
+		return Fail(t, fmt.Sprintf("Error message should contain %q\n"+
+			"in chain:\n%s", contains, errorChain(theError)), msgAndArgs...)
+	}
+
+	return true
+}
+
 // Empty asserts that the specified object is empty.  I.e. nil, "", false, 0 or either
 // a slice or a channel with len == 0.
 //
@@ -153,6 +432,10 @@ func Nil(t TestingT, object interface{}, msgAndArgs ...interface{}) bool {
 //
 // Returns whether the assertion was successful (true) or not (false).
 func Empty(t TestingT, object interface{}, msgAndArgs ...interface{}) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+
 	pass := isEmpty(object)
 	if !pass {
 		Fail(t, fmt.Sprintf("Should be empty, but was %v", object), msgAndArgs...)
@@ -170,6 +453,10 @@ func Empty(t TestingT, object interface{}, msgAndArgs ...interface{}) bool {
 //
 // Returns whether the assertion was successful (true) or not (false).
 func NotEmpty(t TestingT, object interface{}, msgAndArgs ...interface{}) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+
 	pass := !isEmpty(object)
 	if !pass {
 		Fail(t, fmt.Sprintf("Should NOT be empty, but was %v", object), msgAndArgs...)
@@ -185,9 +472,13 @@ func NotEmpty(t TestingT, object interface{}, msgAndArgs ...interface{}) bool {
 //
 // Returns whether the assertion was successful (true) or not (false).
 func Len(t TestingT, object interface{}, length int, msgAndArgs ...interface{}) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+
 	ok, l := getLen(object)
 	if !ok {
-		return Fail(t, fmt.Sprintf("\"%s\" could not be applied builtin len()", object), msgAndArgs...)
+		return failMisuse(t, fmt.Sprintf("\"%s\" could not be applied builtin len()", object), msgAndArgs...)
 	}
 
 	if l != length {
@@ -203,6 +494,10 @@ func Len(t TestingT, object interface{}, length int, msgAndArgs ...interface{})
 //
 // Returns whether the assertion was successful (true) or not (false).
 func True(t TestingT, value bool, msgAndArgs ...interface{}) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+
 	if value != true {
 		return Fail(t, "Should be true", msgAndArgs...)
 	}
@@ -216,6 +511,10 @@ func True(t TestingT, value bool, msgAndArgs ...interface{}) bool {
 //
 // Returns whether the assertion was successful (true) or not (false).
 func False(t TestingT, value bool, msgAndArgs ...interface{}) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+
 	if value != false {
 		return Fail(t, "Should be false", msgAndArgs...)
 	}
@@ -229,6 +528,10 @@ func False(t TestingT, value bool, msgAndArgs ...interface{}) bool {
 //
 // Returns whether the assertion was successful (true) or not (false).
 func NotEqual(t TestingT, expected, actual interface{}, msgAndArgs ...interface{}) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+
 	if objectsAreEqual(expected, actual) {
 		return Fail(t, "Should not be equal", msgAndArgs...)
 	}
@@ -237,38 +540,194 @@ func NotEqual(t TestingT, expected, actual interface{}, msgAndArgs ...interface{
 }
 
 // Contains asserts that the specified string or list(array, slice...) contains the
-// specified substring or element.
+// specified substring or element, or that the specified map contains the key.
+// A []byte may contain a byte or a sub-slice given as a []byte or string, a
+// fmt.Stringer contains any substring of its String(), and a channel contains the
+// values buffered in it when Contains is called. Looking at these values means
+// receiving them and sending them back, so a channel should not be in use by
+// other goroutines at the time, and one that is closed cannot be checked.
 //
 //    assert.Contains(t, "Hello World", "World", "But 'Hello World' does contain 'World'")
 //    assert.Contains(t, ["Hello", "World"], "World", "But ["Hello", "World"] does contain 'World'")
+//    assert.Contains(t, {"Hello": "World"}, "Hello", "But {'Hello': 'World'} does contain 'Hello'")
 //
 // Returns whether the assertion was successful (true) or not (false).
 func Contains(t TestingT, s, contains interface{}, msgAndArgs ...interface{}) bool {
-	ok, found := includeElement(s, contains)
-	if !ok {
-		return Fail(t, fmt.Sprintf("\"%s\" could not be applied builtin len()", s), msgAndArgs...)
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
 	}
-	if !found {
-		return Fail(t, fmt.Sprintf("\"%s\" does not contain \"%s\"", s, contains), msgAndArgs...)
+
+	result, err := searchList(s, contains)
+	if err != nil {
+		return failMisuse(t, err.Error(), msgAndArgs...)
+	}
+	if !result.found {
+		return Fail(t, fmt.Sprintf("%s does not %s", result.subject, result.relation), msgAndArgs...)
 	}
 
 	return true
 }
 
 // NotContains asserts that the specified string or list(array, slice...) does NOT contain the
-// specified substring or element.
+// specified substring or element, or that the specified map does NOT contain the key.
 //
 //    assert.NotContains(t, "Hello World", "Earth", "But 'Hello World' does NOT contain 'Earth'")
 //    assert.NotContains(t, ["Hello", "World"], "Earth", "But ['Hello', 'World'] does NOT contain 'Earth'")
 //
 // Returns whether the assertion was successful (true) or not (false).
 func NotContains(t TestingT, s, contains interface{}, msgAndArgs ...interface{}) bool {
-	ok, found := includeElement(s, contains)
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+
+	result, err := searchList(s, contains)
+	if err != nil {
+		return failMisuse(t, err.Error(), msgAndArgs...)
+	}
+	if result.found {
+		return Fail(t, fmt.Sprintf("%s should not %s", result.subject, result.relation), msgAndArgs...)
+	}
+
+	return true
+}
+
+// ElementsMatch asserts that the specified lists (arrays or slices) contain the
+// same elements, ignoring their order. Duplicates must appear the same number of
+// times in each list.
+//
+//    assert.ElementsMatch(t, []int{1, 3, 2, 3}, []int{3, 3, 1, 2})
+//
+// Returns whether the assertion was successful (true) or not (false).
+func ElementsMatch(t TestingT, expected, actual interface{}, msgAndArgs ...interface{}) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+
+	expectedValue := reflect.ValueOf(expected)
+	actualValue := reflect.ValueOf(actual)
+	if !isList(expectedValue) || !isList(actualValue) {
+		return failMisuse(t, fmt.Sprintf("Parameters must be array or slice, got %T and %T", expected, actual), msgAndArgs...)
+	}
+
+	if diffs := diffElements(expectedValue, actualValue); len(diffs) > 0 {
+		return Fail(t, "Elements do not match (ignoring order):\n"+formatDiffs(diffs), msgAndArgs...)
+	}
+
+	return true
+}
+
+// Subset asserts that the specified list (array or slice) contains all of the
+// elements of subset, or that the specified map contains all of the keys of
+// subset with the same values.
+//
+//    assert.Subset(t, []int{1, 2, 3}, []int{1, 3})
+//    assert.Subset(t, map[string]int{"a": 1, "b": 2}, map[string]int{"a": 1})
+//
+// Returns whether the assertion was successful (true) or not (false).
+func Subset(t TestingT, list, subset interface{}, msgAndArgs ...interface{}) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+
+	diffs, ok := diffSubset(reflect.ValueOf(list), reflect.ValueOf(subset))
 	if !ok {
-		return Fail(t, fmt.Sprintf("\"%s\" could not be applied builtin len()", s), msgAndArgs...)
+		return failMisuse(t, fmt.Sprintf("Parameters must both be arrays or slices, or both be maps, got %T and %T", list, subset), msgAndArgs...)
 	}
-	if found {
-		return Fail(t, fmt.Sprintf("\"%s\" should not contain \"%s\"", s, contains), msgAndArgs...)
+	if len(diffs) > 0 {
+		return Fail(t, fmt.Sprintf("%#v does not contain all of %#v:\n%s", list, subset, formatDiffs(diffs)), msgAndArgs...)
+	}
+
+	return true
+}
+
+// NotSubset asserts that the specified list (array or slice) does NOT contain
+// all of the elements of subset, or that the specified map does NOT contain all
+// of the entries of subset.
+//
+//    assert.NotSubset(t, []int{1, 2, 3}, []int{1, 4})
+//    assert.NotSubset(t, map[string]int{"a": 1, "b": 2}, map[string]int{"a": 2})
+//
+// Returns whether the assertion was successful (true) or not (false).
+func NotSubset(t TestingT, list, subset interface{}, msgAndArgs ...interface{}) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+
+	diffs, ok := diffSubset(reflect.ValueOf(list), reflect.ValueOf(subset))
+	if !ok {
+		return failMisuse(t, fmt.Sprintf("Parameters must both be arrays or slices, or both be maps, got %T and %T", list, subset), msgAndArgs...)
+	}
+	if len(diffs) == 0 {
+		return Fail(t, fmt.Sprintf("%#v should not contain all of %#v", list, subset), msgAndArgs...)
+	}
+
+	return true
+}
+
+// HasKey asserts that the specified map contains the key.
+//
+//    assert.HasKey(t, map[string]int{"a": 1}, "a")
+//
+// Returns whether the assertion was successful (true) or not (false).
+func HasKey(t TestingT, m, key interface{}, msgAndArgs ...interface{}) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+
+	mapValue := reflect.ValueOf(m)
+	if mapValue.Kind() != reflect.Map {
+		return failMisuse(t, fmt.Sprintf("%#v is not a map", m), msgAndArgs...)
+	}
+	if _, found := mapIndex(mapValue, reflect.ValueOf(key)); !found {
+		return Fail(t, fmt.Sprintf("%#v does not have key %#v", m, key), msgAndArgs...)
+	}
+
+	return true
+}
+
+// NotHasKey asserts that the specified map does NOT contain the key.
+//
+//    assert.NotHasKey(t, map[string]int{"a": 1}, "b")
+//
+// Returns whether the assertion was successful (true) or not (false).
+func NotHasKey(t TestingT, m, key interface{}, msgAndArgs ...interface{}) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+
+	mapValue := reflect.ValueOf(m)
+	if mapValue.Kind() != reflect.Map {
+		return failMisuse(t, fmt.Sprintf("%#v is not a map", m), msgAndArgs...)
+	}
+	if value, found := mapIndex(mapValue, reflect.ValueOf(key)); found {
+		return Fail(t, fmt.Sprintf("%#v should not have key %#v, but it has the value %s", m, key, formatValue(value)), msgAndArgs...)
+	}
+
+	return true
+}
+
+// HasEntry asserts that the specified map contains the key, and that its value
+// is equal to the expected value.
+//
+//    assert.HasEntry(t, map[string]int{"a": 1}, "a", 1)
+//
+// Returns whether the assertion was successful (true) or not (false).
+func HasEntry(t TestingT, m, key, expected interface{}, msgAndArgs ...interface{}) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+
+	mapValue := reflect.ValueOf(m)
+	if mapValue.Kind() != reflect.Map {
+		return failMisuse(t, fmt.Sprintf("%#v is not a map", m), msgAndArgs...)
+	}
+
+	value, found := mapIndex(mapValue, reflect.ValueOf(key))
+	if !found {
+		return Fail(t, fmt.Sprintf("%#v does not have key %#v", m, key), msgAndArgs...)
+	}
+	if actual := value.Interface(); !objectsAreEqual(expected, actual) {
+		return Fail(t, fmt.Sprintf("Wrong value for key %#v:\n%s", key, equalFailureMessage(expected, actual)), msgAndArgs...)
 	}
 
 	return true
@@ -276,6 +735,10 @@ func NotContains(t TestingT, s, contains interface{}, msgAndArgs ...interface{})
 
 // Condition uses a Comparison to assert a complex condition.
 func Condition(t TestingT, comp Comparison, msgAndArgs ...interface{}) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+
 	if !comp() {
 		return Fail(t, "Condition failed!", msgAndArgs...)
 	}
@@ -283,6 +746,137 @@ func Condition(t TestingT, comp Comparison, msgAndArgs ...interface{}) bool {
 	return true
 }
 
+// Eventually asserts that the Comparison returns true within waitFor, calling
+// it every tick.
+//
+//    assert.Eventually(t, func() bool { return cache.Len() == 3 }, time.Second, 10*time.Millisecond)
+//
+// Returns whether the assertion was successful (true) or not (false).
+func Eventually(t TestingT, comp Comparison, waitFor, tick time.Duration, msgAndArgs ...interface{}) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+
+	start := time.Now()
+	if polls, found, blocked := poll(comp, waitFor, tick, true); !found {
+		last := "last result was false"
+		if blocked {
+			last = "last call did not return"
+		}
+
+		return Fail(t, fmt.Sprintf("Condition never satisfied: polled %d time(s) over %v, %s", polls, time.Since(start), last), msgAndArgs...)
+	}
+
+	return true
+}
+
+// Never asserts that the Comparison does not return true within waitFor,
+// calling it every tick.
+//
+//    assert.Never(t, func() bool { return worker.Crashed() }, time.Second, 10*time.Millisecond)
+//
+// Returns whether the assertion was successful (true) or not (false).
+func Never(t TestingT, comp Comparison, waitFor, tick time.Duration, msgAndArgs ...interface{}) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+
+	start := time.Now()
+	if polls, found, _ := poll(comp, waitFor, tick, true); found {
+		return Fail(t, fmt.Sprintf("Condition satisfied: poll %d returned true after %v", polls, time.Since(start)), msgAndArgs...)
+	}
+
+	return true
+}
+
+// Consistently asserts that the Comparison returns true every time it is
+// called, every tick, for the whole of waitFor.
+//
+//    assert.Consistently(t, func() bool { return conn.Open() }, time.Second, 10*time.Millisecond)
+//
+// Returns whether the assertion was successful (true) or not (false).
+func Consistently(t TestingT, comp Comparison, waitFor, tick time.Duration, msgAndArgs ...interface{}) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+
+	start := time.Now()
+	polls, found, blocked := poll(comp, waitFor, tick, false)
+	if found {
+		return Fail(t, fmt.Sprintf("Condition not satisfied: poll %d returned false after %v", polls, time.Since(start)), msgAndArgs...)
+	}
+	if blocked {
+		return Fail(t, fmt.Sprintf("Condition not satisfied: poll %d did not return within %v", polls, time.Since(start)), msgAndArgs...)
+	}
+
+	return true
+}
+
+// EventuallyWithT asserts that all of the assertions made in condition pass
+// within waitFor, calling it every tick. Failures from attempts other than the
+// last are discarded, unless the last attempt did not return, in which case the
+// failures of the attempt before it are reported.
+//
+//    assert.EventuallyWithT(t, func(c *assert.CollectT) {
+//      assert.Equal(c, 3, cache.Len())
+//      assert.Contains(c, cache.Keys(), "a")
+//    }, time.Second, 10*time.Millisecond)
+//
+// Returns whether the assertion was successful (true) or not (false).
+func EventuallyWithT(t TestingT, condition func(c *CollectT), waitFor, tick time.Duration, msgAndArgs ...interface{}) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+
+	var (
+		mu   sync.Mutex
+		last *CollectT
+		done bool
+	)
+	start := time.Now()
+
+	polls, found, blocked := poll(func() bool {
+		c := new(CollectT)
+		c.run(condition)
+
+		// An attempt that was abandoned must not replace the failures of the
+		// one before it once polling has finished
+		mu.Lock()
+		if !done {
+			last = c
+		}
+		mu.Unlock()
+
+		return !c.Failed()
+	}, waitFor, tick, true)
+
+	mu.Lock()
+	defer mu.Unlock()
+	done = true
+
+	if !found {
+		attempt := "the last attempt"
+		if blocked && last != nil {
+			attempt = "an earlier attempt, as the last did not return"
+		}
+
+		var failures []string
+		switch {
+		case last == nil:
+			failures = []string{"condition did not return"}
+		case len(last.soft.failures) == 0:
+			failures = []string{"FailNow called"}
+		default:
+			failures = last.soft.failures
+		}
+
+		return Fail(t, fmt.Sprintf("Condition never satisfied: ran %d time(s) over %v, failures from %s:\n\n%s",
+			polls, time.Since(start), attempt, formatFailures(failures)), msgAndArgs...)
+	}
+
+	return true
+}
+
 // Panics asserts that the code inside the specified func panics.
 //
 //   assert.Panics(t, func(){
@@ -291,8 +885,108 @@ func Condition(t TestingT, comp Comparison, msgAndArgs ...interface{}) bool {
 //
 // Returns whether the assertion was successful (true) or not (false).
 func Panics(t TestingT, f func(), msgAndArgs ...interface{}) bool {
-	if funcDidPanic, panicValue := didPanic(f); !funcDidPanic {
-		return Fail(t, fmt.Sprintf("func should panic\n\r\tPanic value:\t%v", panicValue), msgAndArgs...)
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+
+	if funcDidPanic, _, _ := didPanic(f); !funcDidPanic {
+		return Fail(t, "func should panic", msgAndArgs...)
+	}
+
+	return true
+}
+
+// PanicsWithValue asserts that the code inside the specified func panics, and
+// that the recovered panic value equals the expected value.
+//
+//   assert.PanicsWithValue(t, "crazy error", func(){
+//     GoCrazy()
+//   }, "Calling GoCrazy() should panic with 'crazy error'")
+//
+// Returns whether the assertion was successful (true) or not (false).
+func PanicsWithValue(t TestingT, expected interface{}, f func(), msgAndArgs ...interface{}) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+
+	funcDidPanic, panicValue, panicStack := didPanic(f)
+	if !funcDidPanic {
+		return Fail(t, fmt.Sprintf("func should panic with value:\t%#v", expected), msgAndArgs...)
+	}
+	if !objectsAreEqual(expected, panicValue) {
+		return Fail(t, fmt.Sprintf("func should panic with value:\t%#v\n\tPanic value:\t%#v\n\tPanic stack:\t%s",
+			expected, panicValue, panicStack), msgAndArgs...)
+	}
+
+	return true
+}
+
+// PanicsWithError asserts that the code inside the specified func panics with an
+// error. If expected is an error the recovered error must match it using
+// errors.Is, otherwise the message of the recovered error must equal expected.
+//
+//   assert.PanicsWithError(t, ErrCrazy, func(){
+//     GoCrazy()
+//   })
+//   assert.PanicsWithError(t, "crazy error", func(){
+//     GoCrazy()
+//   })
+//
+// Returns whether the assertion was successful (true) or not (false).
+func PanicsWithError(t TestingT, expected interface{}, f func(), msgAndArgs ...interface{}) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+
+	funcDidPanic, panicValue, panicStack := didPanic(f)
+	if !funcDidPanic {
+		return Fail(t, fmt.Sprintf("func should panic with error:\t%v", expected), msgAndArgs...)
+	}
+
+	err, ok := panicValue.(error)
+	if !ok {
+		return Fail(t, fmt.Sprintf("func should panic with error:\t%v\n\tPanic value:\t%#v\n\tPanic stack:\t%s",
+			expected, panicValue, panicStack), msgAndArgs...)
+	}
+
+	if target, ok := expected.(error); ok {
+		if !errors.Is(err, target) {
+			return Fail(t, fmt.Sprintf("func should panic with error:\t%s\n\tPanic value:\n%s\n\tPanic stack:\t%s",
+				errorChain(target), errorChain(err), panicStack), msgAndArgs...)
+		}
+
+		return true
+	}
+
+	if err.Error() != fmt.Sprint(expected) {
+		return Fail(t, fmt.Sprintf("func should panic with error message:\t%q\n\tPanic value:\t%q\n\tPanic stack:\t%s",
+			fmt.Sprint(expected), err.Error(), panicStack), msgAndArgs...)
+	}
+
+	return true
+}
+
+// PanicsMatching asserts that the code inside the specified func panics, and
+// that the recovered panic value matches the regexp. Errors are matched using
+// their message, and all other values are formatted with %v.
+//
+//   assert.PanicsMatching(t, "index out of range", func(){
+//     GoCrazy()
+//   })
+//
+// Returns whether the assertion was successful (true) or not (false).
+func PanicsMatching(t TestingT, rx interface{}, f func(), msgAndArgs ...interface{}) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+
+	funcDidPanic, panicValue, panicStack := didPanic(f)
+	if !funcDidPanic {
+		return Fail(t, fmt.Sprintf("func should panic matching:\t%v", rx), msgAndArgs...)
+	}
+	if !matchRegexp(rx, panicValue) {
+		return Fail(t, fmt.Sprintf("func should panic matching:\t%v\n\tPanic value:\t%v\n\tPanic stack:\t%s",
+			rx, panicValue, panicStack), msgAndArgs...)
 	}
 
 	return true
@@ -306,8 +1000,12 @@ func Panics(t TestingT, f func(), msgAndArgs ...interface{}) bool {
 //
 // Returns whether the assertion was successful (true) or not (false).
 func NotPanics(t TestingT, f func(), msgAndArgs ...interface{}) bool {
-	if funcDidPanic, panicValue := didPanic(f); funcDidPanic {
-		return Fail(t, fmt.Sprintf("func should not panic\n\r\tPanic value:\t%v", panicValue), msgAndArgs...)
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+
+	if funcDidPanic, panicValue, panicStack := didPanic(f); funcDidPanic {
+		return Fail(t, fmt.Sprintf("func should not panic\n\tPanic value:\t%v\n\tPanic stack:\t%s", panicValue, panicStack), msgAndArgs...)
 	}
 
 	return true
@@ -319,6 +1017,10 @@ func NotPanics(t TestingT, f func(), msgAndArgs ...interface{}) bool {
 //
 // Returns whether the assertion was successful (true) or not (false).
 func WithinDuration(t TestingT, expected, actual time.Time, delta time.Duration, msgAndArgs ...interface{}) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+
 	dt := expected.Sub(actual)
 	if dt < -delta || dt > delta {
 		return Fail(t, fmt.Sprintf("Max difference between %v and %v allowed is %v, but difference was %v", expected, actual, delta, dt), msgAndArgs...)
@@ -333,11 +1035,15 @@ func WithinDuration(t TestingT, expected, actual time.Time, delta time.Duration,
 //
 // Returns whether the assertion was successful (true) or not (false).
 func InDelta(t TestingT, expected, actual interface{}, delta float64, msgAndArgs ...interface{}) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+
 	af, aok := toFloat(expected)
 	bf, bok := toFloat(actual)
 
 	if !aok || !bok {
-		return Fail(t, fmt.Sprintf("Parameters must be numerical"), msgAndArgs...)
+		return failMisuse(t, fmt.Sprintf("Parameters must be numerical"), msgAndArgs...)
 	}
 
 	if math.IsNaN(af) {
@@ -363,6 +1069,10 @@ var InDeltaSlice = inSlice(InDelta)
 //
 // Returns whether the assertion was successful (true) or not (false).
 func InEpsilon(t TestingT, expected, actual interface{}, epsilon float64, msgAndArgs ...interface{}) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+
 	delta := calcEpsilonDelta(expected, actual, epsilon)
 
 	return InDelta(t, expected, actual, delta, msgAndArgs...)
@@ -371,6 +1081,237 @@ func InEpsilon(t TestingT, expected, actual interface{}, epsilon float64, msgAnd
 // InEpsilonSlice is the same as InEpsilon, except it compares two slices.
 var InEpsilonSlice = inSlice(InEpsilon)
 
+// Greater asserts that the first element is greater than the second. Both must
+// be the same type, which can be any number, string, time.Time or big.Int.
+//
+//    assert.Greater(t, 2, 1)
+//    assert.Greater(t, "b", "a")
+//    assert.Greater(t, time.Now(), start)
+//
+// Returns whether the assertion was successful (true) or not (false).
+func Greater(t TestingT, e1, e2 interface{}, msgAndArgs ...interface{}) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+
+	return compareTwo(t, e1, e2, func(c int) bool { return c > 0 }, "greater than", msgAndArgs...)
+}
+
+// GreaterOrEqual asserts that the first element is greater than or equal to the
+// second.
+//
+//    assert.GreaterOrEqual(t, 2, 1)
+//    assert.GreaterOrEqual(t, 2, 2)
+//
+// Returns whether the assertion was successful (true) or not (false).
+func GreaterOrEqual(t TestingT, e1, e2 interface{}, msgAndArgs ...interface{}) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+
+	return compareTwo(t, e1, e2, func(c int) bool { return c >= 0 }, "greater than or equal to", msgAndArgs...)
+}
+
+// Less asserts that the first element is less than the second.
+//
+//    assert.Less(t, 1, 2)
+//    assert.Less(t, time.Second, timeout)
+//
+// Returns whether the assertion was successful (true) or not (false).
+func Less(t TestingT, e1, e2 interface{}, msgAndArgs ...interface{}) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+
+	return compareTwo(t, e1, e2, func(c int) bool { return c < 0 }, "less than", msgAndArgs...)
+}
+
+// LessOrEqual asserts that the first element is less than or equal to the
+// second.
+//
+//    assert.LessOrEqual(t, 1, 2)
+//    assert.LessOrEqual(t, 2, 2)
+//
+// Returns whether the assertion was successful (true) or not (false).
+func LessOrEqual(t TestingT, e1, e2 interface{}, msgAndArgs ...interface{}) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+
+	return compareTwo(t, e1, e2, func(c int) bool { return c <= 0 }, "less than or equal to", msgAndArgs...)
+}
+
+// Positive asserts that the specified number, time.Duration or big.Int is
+// greater than zero.
+//
+//    assert.Positive(t, 1)
+//    assert.Positive(t, elapsed)
+//
+// Returns whether the assertion was successful (true) or not (false).
+func Positive(t TestingT, e interface{}, msgAndArgs ...interface{}) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+
+	return checkSign(t, e, func(s int) bool { return s > 0 }, "positive", msgAndArgs...)
+}
+
+// Negative asserts that the specified number, time.Duration or big.Int is less
+// than zero.
+//
+//    assert.Negative(t, -1)
+//
+// Returns whether the assertion was successful (true) or not (false).
+func Negative(t TestingT, e interface{}, msgAndArgs ...interface{}) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+
+	return checkSign(t, e, func(s int) bool { return s < 0 }, "negative", msgAndArgs...)
+}
+
+// Zero asserts that the specified value is zero. Numbers, time.Duration and
+// big.Int are zero when they equal 0, values with an IsZero() bool method, such
+// as time.Time, when it returns true, and any other value when it is the zero
+// value of its type, such as "" or nil.
+//
+//    assert.Zero(t, balance.Sub(credits))
+//
+// Returns whether the assertion was successful (true) or not (false).
+func Zero(t TestingT, e interface{}, msgAndArgs ...interface{}) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+
+	if !isZero(e) {
+		return Fail(t, fmt.Sprintf("%s is not zero", formatOperand(e)), msgAndArgs...)
+	}
+
+	return true
+}
+
+// NotZero asserts that the specified value is not zero, as described for Zero.
+//
+//    assert.NotZero(t, elapsed)
+//
+// Returns whether the assertion was successful (true) or not (false).
+func NotZero(t TestingT, e interface{}, msgAndArgs ...interface{}) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+
+	if isZero(e) {
+		return Fail(t, fmt.Sprintf("%s is not non-zero", formatOperand(e)), msgAndArgs...)
+	}
+
+	return true
+}
+
+// IsSorted asserts that the specified list is sorted in ascending order, allowing
+// equal elements. The list can be an array or slice of any type accepted by
+// Greater, or a sort.Interface.
+//
+//    assert.IsSorted(t, []string{"a", "b", "b"})
+//    assert.IsSorted(t, sort.IntSlice(ids))
+//
+// Returns whether the assertion was successful (true) or not (false).
+func IsSorted(t TestingT, list interface{}, msgAndArgs ...interface{}) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+
+	if data, ok := list.(sort.Interface); ok {
+		for i := 1; i < data.Len(); i++ {
+			if data.Less(i, i-1) {
+				return Fail(t, fmt.Sprintf("Not sorted: [%d] is less than [%d]", i, i-1), msgAndArgs...)
+			}
+		}
+
+		return true
+	}
+
+	return checkOrder(t, list, func(c int) bool { return c >= 0 }, "sorted", "greater than or equal to", msgAndArgs...)
+}
+
+// IsSortedBy asserts that the specified list is sorted according to less, which
+// must be of the form func(a, b T) bool where T is the type of the elements.
+//
+//    assert.IsSortedBy(t, events, func(a, b Event) bool {
+//      return a.At.Before(b.At)
+//    })
+//
+// Returns whether the assertion was successful (true) or not (false).
+func IsSortedBy(t TestingT, list, less interface{}, msgAndArgs ...interface{}) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+
+	listValue := reflect.ValueOf(list)
+	if !isList(listValue) {
+		return failMisuse(t, fmt.Sprintf("%#v is not an array or slice", list), msgAndArgs...)
+	}
+
+	lessValue := reflect.ValueOf(less)
+	elemType := listValue.Type().Elem()
+	if lessValue.Kind() != reflect.Func || lessValue.Type().NumIn() != 2 || lessValue.Type().NumOut() != 1 ||
+		lessValue.Type().In(0) != elemType || lessValue.Type().In(1) != elemType ||
+		lessValue.Type().Out(0).Kind() != reflect.Bool {
+		return failMisuse(t, fmt.Sprintf("less must be of the form func(a, b %v) bool, got %T", elemType, less), msgAndArgs...)
+	}
+
+	for i := 1; i < listValue.Len(); i++ {
+		prev, next := listValue.Index(i-1), listValue.Index(i)
+		if lessValue.Call([]reflect.Value{next, prev})[0].Bool() {
+			return Fail(t, fmt.Sprintf("Not sorted: [%d] %s is less than [%d] %s",
+				i, formatValue(next), i-1, formatValue(prev)), msgAndArgs...)
+		}
+	}
+
+	return true
+}
+
+// IsIncreasing asserts that each element of the specified list is greater than
+// the one before it.
+//
+//    assert.IsIncreasing(t, []int{1, 2, 3})
+//
+// Returns whether the assertion was successful (true) or not (false).
+func IsIncreasing(t TestingT, list interface{}, msgAndArgs ...interface{}) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+
+	return checkOrder(t, list, func(c int) bool { return c > 0 }, "increasing", "greater than", msgAndArgs...)
+}
+
+// IsNonDecreasing asserts that each element of the specified list is greater
+// than or equal to the one before it.
+//
+//    assert.IsNonDecreasing(t, []int{1, 1, 2})
+//
+// Returns whether the assertion was successful (true) or not (false).
+func IsNonDecreasing(t TestingT, list interface{}, msgAndArgs ...interface{}) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+
+	return checkOrder(t, list, func(c int) bool { return c >= 0 }, "non-decreasing", "greater than or equal to", msgAndArgs...)
+}
+
+// IsDecreasing asserts that each element of the specified list is less than the
+// one before it.
+//
+//    assert.IsDecreasing(t, []int{3, 2, 1})
+//
+// Returns whether the assertion was successful (true) or not (false).
+func IsDecreasing(t TestingT, list interface{}, msgAndArgs ...interface{}) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+
+	return checkOrder(t, list, func(c int) bool { return c < 0 }, "decreasing", "less than", msgAndArgs...)
+}
+
 // Regexp asserts that a specified regexp matches a string.
 //
 //  assert.Regexp(t, regexp.MustCompile("start"), "it's starting")
@@ -378,6 +1319,10 @@ var InEpsilonSlice = inSlice(InEpsilon)
 //
 // Returns whether the assertion was successful (true) or not (false).
 func Regexp(t TestingT, rx interface{}, str interface{}, msgAndArgs ...interface{}) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+
 	if !matchRegexp(rx, str) {
 		return Fail(t, fmt.Sprintf("Expect \"%v\" to match \"%v\"", str, rx), msgAndArgs...)
 	}
@@ -392,6 +1337,10 @@ func Regexp(t TestingT, rx interface{}, str interface{}, msgAndArgs ...interface
 //
 // Returns whether the assertion was successful (true) or not (false).
 func NotRegexp(t TestingT, rx interface{}, str interface{}, msgAndArgs ...interface{}) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+
 	if matchRegexp(rx, str) {
 		return Fail(t, fmt.Sprintf("Expect \"%v\" to NOT match \"%v\"", str, rx), msgAndArgs...)
 	}
@@ -1,10 +1,17 @@
 package assert
 
 import (
+	"bytes"
 	"errors"
+	"fmt"
 	"io"
+	"io/fs"
 	"math"
+	"math/big"
 	"regexp"
+	"runtime"
+	"sort"
+	"strings"
 	"testing"
 	"time"
 )
@@ -25,6 +32,26 @@ func (a *AssertionTesterConformingObject) TestMethod() {
 type AssertionTesterNonConformingObject struct {
 }
 
+// bufferT is a TestingT that records the failures reported to it.
+type bufferT struct {
+	buf bytes.Buffer
+}
+
+func (t *bufferT) Errorf(format string, args ...interface{}) {
+	fmt.Fprintf(&t.buf, format, args...)
+}
+
+// helperT is a bufferT that can also mark helpers, counting how many times it
+// was asked to.
+type helperT struct {
+	bufferT
+	helpers int
+}
+
+func (t *helperT) Helper() {
+	t.helpers++
+}
+
 func TestObjectsAreEqual(t *testing.T) {
 
 	if !objectsAreEqual("Hello World", "Hello World") {
@@ -120,6 +147,70 @@ func TestEqual(t *testing.T) {
 
 }
 
+type equalWithRecord struct {
+	ID        int
+	Name      string
+	CreatedAt time.Time
+	Tags      []string
+	secret    string
+}
+
+func TestEqualWith(t *testing.T) {
+
+	mockT := new(testing.T)
+
+	a := equalWithRecord{ID: 1, Name: "a", CreatedAt: time.Now(), secret: "x"}
+	b := equalWithRecord{ID: 2, Name: "a", CreatedAt: a.CreatedAt.Add(time.Second), Tags: []string{}, secret: "y"}
+
+	if EqualWith(mockT, a, b) {
+		t.Error("EqualWith should return false")
+	}
+	if !EqualWith(mockT, a, b, IgnoreFields("ID", "CreatedAt"), IgnoreUnexported(), EquateEmpty()) {
+		t.Error("EqualWith should return true")
+	}
+	if EqualWith(mockT, a, b, IgnoreFields("ID", "CreatedAt"), IgnoreUnexported()) {
+		t.Error("EqualWith should return false: nil and empty slices differ")
+	}
+
+	utc := a.CreatedAt.UTC()
+	if EqualWith(mockT, a.CreatedAt, utc) {
+		t.Error("EqualWith should return false")
+	}
+	if !EqualWith(mockT, a.CreatedAt, utc, UseEqualMethod()) {
+		t.Error("EqualWith should return true")
+	}
+
+	caseless := Comparer(func(x, y string) bool { return strings.EqualFold(x, y) })
+	if !EqualWith(mockT, []string{"A", "b"}, []string{"a", "B"}, caseless) {
+		t.Error("EqualWith should return true")
+	}
+	if !EqualWith(mockT, a, b, "user %d", 1, IgnoreFields("ID", "CreatedAt"), IgnoreUnexported(), EquateEmpty()) {
+		t.Error("EqualWith should return true, using the options among the message arguments")
+	}
+	if !EqualWith(mockT, nil, nil) {
+		t.Error("EqualWith should return true")
+	}
+	if EqualWith(mockT, nil, a) {
+		t.Error("EqualWith should return false")
+	}
+
+	Panics(t, func() { Comparer(func(x string) bool { return true }) })
+
+	bufT := new(bufferT)
+	EqualWith(bufT, a, b, IgnoreFields("CreatedAt", "secret"))
+	Contains(t, bufT.buf.String(), ".ID: 1 != 2")
+	NotContains(t, bufT.buf.String(), ".CreatedAt")
+
+	helpT := new(helperT)
+	EqualWith(helpT, a, b, IgnoreFields("CreatedAt", "secret"), "user %d", 1, KV{"id": 1})
+	Contains(t, helpT.buf.String(), "Messages:\tuser 1\n\t\t\tid: 1\n")
+
+	helpT = new(helperT)
+	EqualWith(helpT, nil, a, "missing")
+	Contains(t, helpT.buf.String(), "Messages:\tmissing\n")
+
+}
+
 func TestNotNil(t *testing.T) {
 
 	mockT := new(testing.T)
@@ -146,6 +237,132 @@ func TestNil(t *testing.T) {
 
 }
 
+func TestError(t *testing.T) {
+
+	mockT := new(testing.T)
+
+	if !Error(mockT, AnError) {
+		t.Error("Error should return true: error is not nil")
+	}
+	if Error(mockT, nil) {
+		t.Error("Error should return false: error is nil")
+	}
+
+}
+
+func TestNoError(t *testing.T) {
+
+	mockT := new(testing.T)
+
+	if !NoError(mockT, nil) {
+		t.Error("NoError should return true: error is nil")
+	}
+	if NoError(mockT, AnError) {
+		t.Error("NoError should return false: error is not nil")
+	}
+
+	bufT := new(bufferT)
+	NoError(bufT, fmt.Errorf("open config: %w", io.EOF))
+	Contains(t, bufT.buf.String(), "Received unexpected error:\n\t*fmt.wrapError: \"open config: EOF\"\n\t  *errors.errorString: \"EOF\"")
+
+}
+
+func TestErrorIs(t *testing.T) {
+
+	mockT := new(testing.T)
+	wrapped := fmt.Errorf("wrapped: %w", io.EOF)
+	joined := errors.Join(AnError, wrapped)
+
+	if !ErrorIs(mockT, wrapped, io.EOF) {
+		t.Error("ErrorIs should return true")
+	}
+	if !ErrorIs(mockT, joined, io.EOF) {
+		t.Error("ErrorIs should return true")
+	}
+	if ErrorIs(mockT, wrapped, io.ErrUnexpectedEOF) {
+		t.Error("ErrorIs should return false")
+	}
+	if ErrorIs(mockT, nil, io.EOF) {
+		t.Error("ErrorIs should return false")
+	}
+
+	if !NotErrorIs(mockT, wrapped, io.ErrUnexpectedEOF) {
+		t.Error("NotErrorIs should return true")
+	}
+	if NotErrorIs(mockT, joined, io.EOF) {
+		t.Error("NotErrorIs should return false")
+	}
+
+	bufT := new(bufferT)
+	ErrorIs(bufT, joined, io.ErrUnexpectedEOF)
+	Contains(t, bufT.buf.String(), "in chain:\n\t*errors.joinError:")
+	Contains(t, bufT.buf.String(), "\n\t    *errors.errorString: \"EOF\"")
+
+}
+
+type errorAsTarget struct{}
+
+func (errorAsTarget) Error() string { return "target" }
+
+func TestErrorAs(t *testing.T) {
+
+	mockT := new(testing.T)
+	wrapped := fmt.Errorf("wrapped: %w", errorAsTarget{})
+
+	var target errorAsTarget
+	if !ErrorAs(mockT, wrapped, &target) {
+		t.Error("ErrorAs should return true")
+	}
+
+	var pathErr *fs.PathError
+	if ErrorAs(mockT, wrapped, &pathErr) {
+		t.Error("ErrorAs should return false")
+	}
+	if ErrorAs(mockT, wrapped, nil) {
+		t.Error("ErrorAs should return false for a nil target")
+	}
+	if ErrorAs(mockT, wrapped, target) {
+		t.Error("ErrorAs should return false for a non-pointer target")
+	}
+	var s string
+	if ErrorAs(mockT, wrapped, &s) {
+		t.Error("ErrorAs should return false for a non-error target")
+	}
+
+}
+
+func TestEqualError(t *testing.T) {
+
+	mockT := new(testing.T)
+
+	if !EqualError(mockT, errors.New("some error"), "some error") {
+		t.Error("EqualError should return true")
+	}
+	if EqualError(mockT, errors.New("some error"), "other error") {
+		t.Error("EqualError should return false")
+	}
+	if EqualError(mockT, nil, "some error") {
+		t.Error("EqualError should return false")
+	}
+
+}
+
+func TestErrorContains(t *testing.T) {
+
+	mockT := new(testing.T)
+
+	if !ErrorContains(mockT, errors.New("some error"), "some") {
+		t.Error("ErrorContains should return true")
+	}
+	if ErrorContains(mockT, errors.New("some error"), "other") {
+		t.Error("ErrorContains should return false")
+	}
+	if ErrorContains(mockT, nil, "some") {
+		t.Error("ErrorContains should return false")
+	}
+
+}
+
 func TestTrue(t *testing.T) {
 
 	mockT := new(testing.T)
@@ -299,6 +516,119 @@ func TestNotContains(t *testing.T) {
 
 }
 
+type containsStringer struct{}
+
+func (containsStringer) String() string { return "Hello World" }
+
+func TestContainsKinds(t *testing.T) {
+
+	mockT := new(testing.T)
+
+	type name string
+	ch := make(chan int, 3)
+	ch <- 1
+	ch <- 2
+
+	cases := []struct {
+		list, element interface{}
+		result        bool
+	}{
+		{map[string]int{"a": 1}, "a", true},
+		{map[string]int{"a": 1}, 1, false},
+		{[2]int{1, 2}, 2, true},
+		{[2]int{1, 2}, 3, false},
+		{[]byte("Hello World"), []byte("lo W"), true},
+		{[]byte("Hello World"), "World", true},
+		{[]byte("Hello World"), byte('H'), true},
+		{[]byte("Hello World"), "Salut", false},
+		{ch, 2, true},
+		{ch, 3, false},
+		{make(chan int), 0, false},
+		{containsStringer{}, "World", true},
+		{containsStringer{}, "Salut", false},
+		{name("Hello World"), "World", true},
+		{time.Second, "1s", true},
+	}
+
+	for _, c := range cases {
+		if Contains(mockT, c.list, c.element) != c.result {
+			t.Errorf("Contains(%#v, %#v) should return %v", c.list, c.element, c.result)
+		}
+		if NotContains(mockT, c.list, c.element) == c.result {
+			t.Errorf("NotContains(%#v, %#v) should return %v", c.list, c.element, !c.result)
+		}
+	}
+
+	if len(ch) != 2 || <-ch != 1 || <-ch != 2 {
+		t.Error("Contains should leave the values buffered in a channel")
+	}
+
+	full := make(chan int, 2)
+	full <- 1
+	full <- 2
+	if !Contains(mockT, full, 2) || len(full) != 2 || <-full != 1 || <-full != 2 {
+		t.Error("Contains should leave the values buffered in a full channel")
+	}
+
+	closed := make(chan int, 2)
+	closed <- 1
+	close(closed)
+	if Contains(mockT, closed, 1) || NotContains(mockT, closed, 1) {
+		t.Error("Contains and NotContains should fail for a closed channel")
+	}
+	if len(closed) != 1 {
+		t.Error("Contains should not receive values from a closed channel")
+	}
+
+	empty := make(chan int)
+	close(empty)
+	if !NotContains(mockT, empty, 1) {
+		t.Error("NotContains should return true for a closed channel with nothing buffered")
+	}
+
+	if Contains(mockT, map[interface{}]int{1: 1}, []int{1}) {
+		t.Error("Contains should return false for an unhashable key")
+	}
+}
+
+func TestContainsFailureMessages(t *testing.T) {
+
+	ch := make(chan int, 2)
+	ch <- 1
+	closed := make(chan int, 1)
+	closed <- 1
+	close(closed)
+
+	cases := []struct {
+		list, element interface{}
+		message       string
+	}{
+		{"Hello World", "Salut", `"Hello World" does not contain "Salut"`},
+		{"Hello World", 1, `"Hello World" is a string, so can only contain a string, not int`},
+		{map[string]int{"a": 1}, "b", `map[string]int{"a":1} does not have key "b"`},
+		{[]int{1, 2}, 3, `[]int{1, 2} does not contain 3`},
+		{[]byte("ab"), 1, `[]byte{0x61, 0x62} is a []byte, so can only contain a byte, []byte or string, not int`},
+		{ch, 2, `chan int with buffered values []int{1} does not contain 2`},
+		{(<-chan int)(ch), 2, `<-chan int must be able to send and receive to look at its buffered values`},
+		{closed, 1, `chan int is closed, so its buffered values cannot be looked at without receiving them`},
+		{containsStringer{}, "Salut", `assert.containsStringer with String() "Hello World" does not contain "Salut"`},
+		{containsStringer{}, 1, `assert.containsStringer is a fmt.Stringer, so can only contain a string, not int`},
+		{1433, "1", `1433 is not a string, array, slice, map, channel or fmt.Stringer`},
+		{nil, "1", `<nil> is not a string, array, slice, map, channel or fmt.Stringer`},
+	}
+
+	for _, c := range cases {
+		bufT := new(bufferT)
+		Contains(bufT, c.list, c.element)
+		Contains(t, bufT.buf.String(), c.message)
+	}
+
+	bufT := new(bufferT)
+	NotContains(bufT, map[string]int{"a": 1}, "a")
+	Contains(t, bufT.buf.String(), `map[string]int{"a":1} should not have key "a"`)
+
+}
+
 func Test_includeElement(t *testing.T) {
 
 	list1 := []string{"Foo", "Bar"}
@@ -340,6 +670,167 @@ func Test_includeElement(t *testing.T) {
 	False(t, ok)
 	False(t, found)
 
+	ok, found = includeElement(map[string]int{"a": 1}, "a")
+	True(t, ok)
+	True(t, found)
+
+	ok, found = includeElement(map[string]int{"a": 1}, 1)
+	True(t, ok)
+	False(t, found)
+
+	ok, found = includeElement(map[interface{}]int{1: 1, nil: 2}, nil)
+	True(t, ok)
+	True(t, found)
+
+}
+
+func TestElementsMatch(t *testing.T) {
+
+	mockT := new(testing.T)
+
+	cases := []struct {
+		expected, actual interface{}
+		result           bool
+	}{
+		{[]int{1, 2, 3}, []int{3, 1, 2}, true},
+		{[]int{1, 1, 2}, []int{1, 2, 1}, true},
+		{[2]string{"a", "b"}, []string{"b", "a"}, true},
+		{[]int{}, []int(nil), true},
+		{[]int{1, 1, 2}, []int{1, 2, 2}, false},
+		{[]int{1, 2}, []int{1, 2, 3}, false},
+		{[]int{1, 2}, "12", false},
+		{nil, []int{}, false},
+	}
+
+	for _, c := range cases {
+		if ElementsMatch(mockT, c.expected, c.actual) != c.result {
+			t.Errorf("ElementsMatch(%#v, %#v) should return %v", c.expected, c.actual, c.result)
+		}
+	}
+
+	bufT := new(helperT)
+	ElementsMatch(bufT, []string{"a", "a", "a", "b"}, []string{"b", "c", "a", "c"})
+	Contains(t, bufT.buf.String(), "Elements do not match (ignoring order):\n"+
+		"\t    missing 2 × \"a\"\n"+
+		"\t    extra 2 × \"c\"")
+
+}
+
+func TestSubset(t *testing.T) {
+
+	mockT := new(testing.T)
+
+	cases := []struct {
+		list, subset interface{}
+		result       bool
+	}{
+		{[]int{1, 2, 3}, []int{3, 1}, true},
+		{[]int{1, 2, 3}, []int{}, true},
+		{[3]string{"a", "b", "c"}, []string{"b"}, true},
+		{[]int{1, 2, 3}, []int{1, 4}, false},
+		{map[string]int{"a": 1, "b": 2}, map[string]int{"a": 1}, true},
+		{map[string]interface{}{"a": 1, "b": "x"}, map[string]int{"a": 1}, true},
+		{map[string]int{"a": 1, "b": 2}, map[string]int{"a": 2}, false},
+		{map[string]int{"a": 1, "b": 2}, map[string]int{"c": 1}, false},
+		{map[string]int{"a": 1}, []string{"a"}, false},
+		{"abc", "a", false},
+	}
+
+	for _, c := range cases {
+		if Subset(mockT, c.list, c.subset) != c.result {
+			t.Errorf("Subset(%#v, %#v) should return %v", c.list, c.subset, c.result)
+		}
+	}
+
+	bufT := new(helperT)
+	Subset(bufT, map[string]int{"a": 1, "b": 2}, map[string]int{"a": 2, "c": 3})
+	Contains(t, bufT.buf.String(), "\t    [\"a\"]: 2 != 1\n\t    [\"c\"]: missing 3")
+
+	bufT = new(helperT)
+	Subset(bufT, []int{1, 2}, []int{3})
+	Contains(t, bufT.buf.String(), "[]int{1, 2} does not contain all of []int{3}:\n\t    missing 3")
+
+}
+
+func TestNotSubset(t *testing.T) {
+
+	mockT := new(testing.T)
+
+	if !NotSubset(mockT, []int{1, 2, 3}, []int{1, 4}) {
+		t.Error("NotSubset should return true")
+	}
+	if NotSubset(mockT, []int{1, 2, 3}, []int{1, 3}) {
+		t.Error("NotSubset should return false")
+	}
+	if !NotSubset(mockT, map[string]int{"a": 1}, map[string]int{"a": 2}) {
+		t.Error("NotSubset should return true")
+	}
+	if NotSubset(mockT, map[string]int{"a": 1, "b": 2}, map[string]int{"b": 2}) {
+		t.Error("NotSubset should return false")
+	}
+	if NotSubset(mockT, 1, 2) {
+		t.Error("NotSubset should return false for non-collections")
+	}
+
+}
+
+func TestHasKey(t *testing.T) {
+
+	mockT := new(testing.T)
+	m := map[string]int{"a": 1}
+
+	if !HasKey(mockT, m, "a") {
+		t.Error("HasKey should return true")
+	}
+	if HasKey(mockT, m, "b") {
+		t.Error("HasKey should return false")
+	}
+	if HasKey(mockT, []string{"a"}, "a") {
+		t.Error("HasKey should return false for a non-map")
+	}
+
+	if !NotHasKey(mockT, m, "b") {
+		t.Error("NotHasKey should return true")
+	}
+	if NotHasKey(mockT, m, "a") {
+		t.Error("NotHasKey should return false")
+	}
+
+	bufT := new(bufferT)
+	HasKey(bufT, "a", "a")
+	Contains(t, bufT.buf.String(), "\"a\" is not a map")
+
+	unhashable := map[interface{}]int{1: 1}
+	if HasKey(mockT, unhashable, []int{1}) || !NotHasKey(mockT, unhashable, []int{1}) {
+		t.Error("an unhashable key should not be found")
+	}
+	if HasEntry(mockT, unhashable, struct{ V interface{} }{[]int{1}}, 1) {
+		t.Error("HasEntry should return false for an unhashable key")
+	}
+}
+
+func TestHasEntry(t *testing.T) {
+
+	mockT := new(testing.T)
+	m := map[string]interface{}{"a": 1, "b": []int{2}}
+
+	if !HasEntry(mockT, m, "a", 1) {
+		t.Error("HasEntry should return true")
+	}
+	if !HasEntry(mockT, m, "b", []int{2}) {
+		t.Error("HasEntry should return true")
+	}
+	if HasEntry(mockT, m, "a", 2) {
+		t.Error("HasEntry should return false for a different value")
+	}
+	if HasEntry(mockT, m, "c", 1) {
+		t.Error("HasEntry should return false for a missing key")
+	}
+
+	bufT := new(bufferT)
+	HasEntry(bufT, m, "a", 2)
+	Contains(t, bufT.buf.String(), "Wrong value for key \"a\"")
+
 }
 
 func TestCondition(t *testing.T) {
@@ -355,19 +846,183 @@ func TestCondition(t *testing.T) {
 
 }
 
+func TestEventually(t *testing.T) {
+	mockT := new(testing.T)
+
+	calls := 0
+	if !Eventually(mockT, func() bool { calls++; return calls == 3 }, time.Second, time.Millisecond) {
+		t.Error("Eventually should return true")
+	}
+	Equal(t, 3, calls)
+
+	if Eventually(mockT, func() bool { return false }, 5*time.Millisecond, time.Millisecond) {
+		t.Error("Eventually should return false")
+	}
+
+	bufT := new(bufferT)
+	Eventually(bufT, func() bool { return false }, 0, time.Millisecond)
+	Contains(t, bufT.buf.String(), "Condition never satisfied: polled 1 time(s)")
+}
+
+func TestNever(t *testing.T) {
+	mockT := new(testing.T)
+
+	if !Never(mockT, func() bool { return false }, 5*time.Millisecond, time.Millisecond) {
+		t.Error("Never should return true")
+	}
+
+	calls := 0
+	if Never(mockT, func() bool { calls++; return calls == 2 }, time.Second, time.Millisecond) {
+		t.Error("Never should return false")
+	}
+
+	bufT := new(bufferT)
+	Never(bufT, func() bool { return true }, time.Second, time.Millisecond)
+	Contains(t, bufT.buf.String(), "Condition satisfied: poll 1 returned true")
+}
+
+func TestConsistently(t *testing.T) {
+	mockT := new(testing.T)
+
+	calls := 0
+	if !Consistently(mockT, func() bool { calls++; return true }, 5*time.Millisecond, time.Millisecond) {
+		t.Error("Consistently should return true")
+	}
+	True(t, calls > 1, "Consistently should poll more than once")
+
+	calls = 0
+	if Consistently(mockT, func() bool { calls++; return calls < 3 }, time.Second, time.Millisecond) {
+		t.Error("Consistently should return false")
+	}
+
+	bufT := new(bufferT)
+	Consistently(bufT, func() bool { return false }, time.Second, time.Millisecond)
+	Contains(t, bufT.buf.String(), "Condition not satisfied: poll 1 returned false")
+}
+
+func TestPollingBlockedCondition(t *testing.T) {
+	block := make(chan struct{})
+	defer close(block)
+	blocked := func() bool { <-block; return true }
+
+	start := time.Now()
+
+	bufT := new(bufferT)
+	if Eventually(bufT, blocked, 5*time.Millisecond, time.Millisecond) {
+		t.Error("Eventually should return false")
+	}
+	Contains(t, bufT.buf.String(), "last call did not return")
+
+	if !Never(new(bufferT), blocked, 5*time.Millisecond, time.Millisecond) {
+		t.Error("Never should return true")
+	}
+
+	bufT = new(bufferT)
+	if Consistently(bufT, blocked, 5*time.Millisecond, time.Millisecond) {
+		t.Error("Consistently should return false")
+	}
+	Contains(t, bufT.buf.String(), "Condition not satisfied: poll 1 did not return")
+
+	bufT = new(bufferT)
+	EventuallyWithT(bufT, func(c *CollectT) { <-block }, 5*time.Millisecond, time.Millisecond)
+	Contains(t, bufT.buf.String(), "condition did not return")
+
+	bufT = new(bufferT)
+	calls := 0
+	EventuallyWithT(bufT, func(c *CollectT) {
+		calls++
+		if calls > 1 {
+			<-block
+		}
+		Equal(c, 1, 2)
+	}, 5*time.Millisecond, time.Millisecond)
+	Contains(t, bufT.buf.String(), "failures from an earlier attempt, as the last did not return")
+	Contains(t, bufT.buf.String(), "Not equal: 1 (expected)")
+
+	True(t, time.Since(start) < time.Second, "blocked conditions should not hang")
+}
+
+func TestPollingNonPositiveTick(t *testing.T) {
+	calls := 0
+	Eventually(new(bufferT), func() bool { calls++; return false }, 50*time.Millisecond, 0)
+	True(t, calls <= 10, "a zero tick should not busy loop")
+
+	polls, found, _ := poll(func() bool { return true }, time.Second, -time.Second, true)
+	Equal(t, 1, polls)
+	True(t, found)
+}
+
+func TestEventuallyWithT(t *testing.T) {
+	mockT := new(testing.T)
+
+	calls := 0
+	if !EventuallyWithT(mockT, func(c *CollectT) {
+		calls++
+		Equal(c, 3, calls)
+	}, time.Second, time.Millisecond) {
+		t.Error("EventuallyWithT should return true")
+	}
+
+	bufT := new(bufferT)
+	calls = 0
+	if EventuallyWithT(bufT, func(c *CollectT) {
+		calls++
+		Equal(c, -1, calls)
+		True(c, calls < 0)
+	}, 5*time.Millisecond, time.Millisecond) {
+		t.Error("EventuallyWithT should return false")
+	}
+
+	output := bufT.buf.String()
+	Contains(t, output, fmt.Sprintf("ran %d time(s)", calls))
+	Contains(t, output, "failures from the last attempt")
+	Contains(t, output, "Failure 1 of 2:")
+	Contains(t, output, fmt.Sprintf("Not equal: -1 (expected)\n\t\t        != %d (actual)", calls))
+	Contains(t, output, "Failure 2 of 2:")
+	Equal(t, 1, strings.Count(output, "Not equal"), "only the last attempt should be reported")
+
+	bufT = new(bufferT)
+	EventuallyWithT(bufT, func(c *CollectT) {
+		c.FailNow()
+		t.Error("FailNow should stop the attempt")
+	}, 0, time.Millisecond)
+	Contains(t, bufT.buf.String(), "FailNow called")
+}
+
+func TestEventuallyWithTErrorTrace(t *testing.T) {
+	bufT := new(bufferT)
+	EventuallyWithT(bufT, func(c *CollectT) {
+		Equal(c, 1, 2)
+	}, 0, time.Millisecond)
+
+	output := bufT.buf.String()
+	Contains(t, output, "Error Trace:\tassertions_test.go:")
+	NotContains(t, output, ".s:", "runtime frames should not be in the trace")
+	NotContains(t, output, "asm_")
+}
+
 func TestDidPanic(t *testing.T) {
 
-	if funcDidPanic, _ := didPanic(func() {
+	if funcDidPanic, _, _ := didPanic(func() {
 		panic("Panic!")
 	}); !funcDidPanic {
 		t.Error("didPanic should return true")
 	}
 
-	if funcDidPanic, _ := didPanic(func() {
+	if funcDidPanic, _, _ := didPanic(func() {
 	}); funcDidPanic {
 		t.Error("didPanic should return false")
 	}
 
+	funcDidPanic, panicValue, panicStack := didPanic(func() {
+		panic(nil)
+	})
+	if !funcDidPanic {
+		t.Error("didPanic should return true for panic(nil)")
+	}
+	IsType(t, &runtime.PanicNilError{}, panicValue)
+	Contains(t, panicStack, "TestDidPanic")
+
 }
 
 func TestPanics(t *testing.T) {
@@ -404,6 +1059,123 @@ func TestNotPanics(t *testing.T) {
 
 }
 
+func TestNotPanicsPrintsStack(t *testing.T) {
+
+	bufT := new(bufferT)
+	NotPanics(bufT, func() {
+		panic("Panic!")
+	})
+	Contains(t, bufT.buf.String(), "Panic value:\tPanic!")
+	Contains(t, bufT.buf.String(), "Panic stack:\tgoroutine")
+
+	bufT = new(bufferT)
+	Panics(bufT, func() {})
+	NotContains(t, bufT.buf.String(), "Panic value")
+
+}
+
+func TestPanicsWithValue(t *testing.T) {
+
+	mockT := new(testing.T)
+
+	if !PanicsWithValue(mockT, "Panic!", func() {
+		panic("Panic!")
+	}) {
+		t.Error("PanicsWithValue should return true")
+	}
+
+	if PanicsWithValue(mockT, "Panic!", func() {
+		panic("Other!")
+	}) {
+		t.Error("PanicsWithValue should return false")
+	}
+
+	if PanicsWithValue(mockT, "Panic!", func() {
+	}) {
+		t.Error("PanicsWithValue should return false")
+	}
+
+	bufT := new(bufferT)
+	PanicsWithValue(bufT, 1, func() {
+		panic(2)
+	})
+	Contains(t, bufT.buf.String(), "func should panic with value:\t1")
+	Contains(t, bufT.buf.String(), "Panic value:\t2")
+	Contains(t, bufT.buf.String(), "Panic stack:\tgoroutine")
+
+}
+
+func TestPanicsWithError(t *testing.T) {
+
+	mockT := new(testing.T)
+
+	if !PanicsWithError(mockT, io.EOF, func() {
+		panic(fmt.Errorf("reading: %w", io.EOF))
+	}) {
+		t.Error("PanicsWithError should return true for an error in the chain")
+	}
+
+	if !PanicsWithError(mockT, "reading: EOF", func() {
+		panic(fmt.Errorf("reading: %w", io.EOF))
+	}) {
+		t.Error("PanicsWithError should return true for a matching message")
+	}
+
+	if PanicsWithError(mockT, io.ErrUnexpectedEOF, func() {
+		panic(io.EOF)
+	}) {
+		t.Error("PanicsWithError should return false for an error not in the chain")
+	}
+
+	if PanicsWithError(mockT, "EOF", func() {
+		panic(io.ErrUnexpectedEOF)
+	}) {
+		t.Error("PanicsWithError should return false for a different message")
+	}
+
+	if PanicsWithError(mockT, "EOF", func() {
+		panic("EOF")
+	}) {
+		t.Error("PanicsWithError should return false for a non-error panic")
+	}
+
+	if PanicsWithError(mockT, io.EOF, func() {
+	}) {
+		t.Error("PanicsWithError should return false")
+	}
+
+}
+
+func TestPanicsMatching(t *testing.T) {
+
+	mockT := new(testing.T)
+
+	if !PanicsMatching(mockT, "^runtime error: index out of range", func() {
+		var a []int
+		_ = a[1]
+	}) {
+		t.Error("PanicsMatching should return true for a matching error")
+	}
+
+	if !PanicsMatching(mockT, regexp.MustCompile(`\d+`), func() {
+		panic(123)
+	}) {
+		t.Error("PanicsMatching should return true for a matching value")
+	}
+
+	if PanicsMatching(mockT, "^Panic", func() {
+		panic("Other!")
+	}) {
+		t.Error("PanicsMatching should return false")
+	}
+
+	if PanicsMatching(mockT, ".*", func() {
+	}) {
+		t.Error("PanicsMatching should return false")
+	}
+
+}
+
 func Test_isEmpty(t *testing.T) {
 
 	chWithValue := make(chan struct{}, 1)
@@ -709,6 +1481,242 @@ func TestInEpsilonSlice(t *testing.T) {
 	False(t, InEpsilonSlice(mockT, "", nil, 1), "Expected non numeral slices to fail")
 }
 
+func TestOrdering(t *testing.T) {
+
+	mockT := new(testing.T)
+
+	now := time.Now()
+	type celsius float64
+
+	cases := []struct {
+		less, greater interface{}
+	}{
+		{1, 2},
+		{int8(-1), int8(1)},
+		{uint64(1), uint64(math.MaxUint64)},
+		{int64(math.MaxInt64 - 1), int64(math.MaxInt64)},
+		{1.5, 2.5},
+		{float32(-1), float32(0)},
+		{celsius(20), celsius(21)},
+		{"a", "b"},
+		{time.Second, time.Minute},
+		{now, now.Add(time.Nanosecond)},
+		{big.NewInt(1), big.NewInt(2)},
+		{*big.NewInt(-5), *big.NewInt(5)},
+	}
+
+	for _, c := range cases {
+		if !Greater(mockT, c.greater, c.less) || Greater(mockT, c.less, c.greater) || Greater(mockT, c.less, c.less) {
+			t.Errorf("Greater is wrong for %#v and %#v", c.greater, c.less)
+		}
+		if !GreaterOrEqual(mockT, c.greater, c.less) || GreaterOrEqual(mockT, c.less, c.greater) || !GreaterOrEqual(mockT, c.less, c.less) {
+			t.Errorf("GreaterOrEqual is wrong for %#v and %#v", c.greater, c.less)
+		}
+		if !Less(mockT, c.less, c.greater) || Less(mockT, c.greater, c.less) || Less(mockT, c.less, c.less) {
+			t.Errorf("Less is wrong for %#v and %#v", c.less, c.greater)
+		}
+		if !LessOrEqual(mockT, c.less, c.greater) || LessOrEqual(mockT, c.greater, c.less) || !LessOrEqual(mockT, c.less, c.less) {
+			t.Errorf("LessOrEqual is wrong for %#v and %#v", c.less, c.greater)
+		}
+	}
+
+	invalid := []struct {
+		e1, e2  interface{}
+		message string
+	}{
+		{1, 1.5, "Cannot compare int and float64, they must be the same type"},
+		{nil, 1, "Cannot compare <nil> and 1"},
+		{math.NaN(), 1.0, "Cannot compare NaN and 1, NaN is not ordered"},
+		{[]int{1}, []int{2}, "Cannot compare values of type []int"},
+	}
+
+	for _, c := range invalid {
+		bufT := new(bufferT)
+		if Greater(bufT, c.e1, c.e2) {
+			t.Errorf("Greater(%#v, %#v) should return false", c.e1, c.e2)
+		}
+		Contains(t, bufT.buf.String(), c.message)
+	}
+
+	bufT := new(bufferT)
+	Greater(bufT, "a", "b")
+	Contains(t, bufT.buf.String(), `"a" is not greater than "b"`)
+
+	bufT = new(bufferT)
+	LessOrEqual(bufT, time.Minute, time.Second)
+	Contains(t, bufT.buf.String(), "1m0s is not less than or equal to 1s")
+
+	bufT = new(bufferT)
+	Less(bufT, *big.NewInt(3), *big.NewInt(2))
+	Contains(t, bufT.buf.String(), "3 is not less than 2")
+
+}
+
+func TestPositiveAndNegative(t *testing.T) {
+
+	mockT := new(testing.T)
+
+	for _, e := range []interface{}{1, int8(1), uint(1), 0.5, float32(0.1), time.Second, big.NewInt(1), *big.NewInt(1)} {
+		if !Positive(mockT, e) || Negative(mockT, e) {
+			t.Errorf("%#v should be positive", e)
+		}
+	}
+	for _, e := range []interface{}{-1, int64(-1), -0.5, -time.Second, big.NewInt(-1)} {
+		if Positive(mockT, e) || !Negative(mockT, e) {
+			t.Errorf("%#v should be negative", e)
+		}
+	}
+	for _, e := range []interface{}{0, uint(0), 0.0, time.Duration(0), big.NewInt(0), math.NaN(), "1", nil, (*big.Int)(nil)} {
+		if Positive(mockT, e) || Negative(mockT, e) {
+			t.Errorf("%#v should be neither positive nor negative", e)
+		}
+	}
+
+	bufT := new(bufferT)
+	Positive(bufT, -time.Second)
+	Contains(t, bufT.buf.String(), "-1s is not positive")
+
+	bufT = new(bufferT)
+	Negative(bufT, "a")
+	Contains(t, bufT.buf.String(), `Cannot check the sign of "a"`)
+
+}
+
+func TestZeroAndNotZero(t *testing.T) {
+
+	mockT := new(testing.T)
+
+	for _, e := range []interface{}{0, uint8(0), 0.0, time.Duration(0), big.NewInt(0), *big.NewInt(0)} {
+		if !Zero(mockT, e) || NotZero(mockT, e) {
+			t.Errorf("%#v should be zero", e)
+		}
+	}
+	for _, e := range []interface{}{1, -1, float32(0.1), -time.Second, big.NewInt(-1)} {
+		if Zero(mockT, e) || !NotZero(mockT, e) {
+			t.Errorf("%#v should not be zero", e)
+		}
+	}
+
+	type point struct{ X, Y int }
+	for _, e := range []interface{}{"", nil, (*big.Int)(nil), time.Time{}, point{}, []int(nil), false} {
+		if !Zero(mockT, e) || NotZero(mockT, e) {
+			t.Errorf("%#v should be zero", e)
+		}
+	}
+	for _, e := range []interface{}{math.NaN(), "0", time.Now(), point{Y: 1}, []int{}, true} {
+		if Zero(mockT, e) || !NotZero(mockT, e) {
+			t.Errorf("%#v should not be zero", e)
+		}
+	}
+
+	bufT := new(bufferT)
+	Zero(bufT, time.Second)
+	Contains(t, bufT.buf.String(), "1s is not zero")
+
+	bufT = new(bufferT)
+	NotZero(bufT, 0.0)
+	Contains(t, bufT.buf.String(), "0 is not non-zero")
+
+	bufT = new(bufferT)
+	Zero(bufT, "a")
+	Contains(t, bufT.buf.String(), `"a" is not zero`)
+
+}
+
+func TestSequenceOrdering(t *testing.T) {
+
+	mockT := new(testing.T)
+
+	cases := []struct {
+		list                                          interface{}
+		sorted, increasing, nonDecreasing, decreasing bool
+	}{
+		{[]int{}, true, true, true, true},
+		{[]int{1}, true, true, true, true},
+		{[]int{1, 2, 3}, true, true, true, false},
+		{[]int{1, 1, 2}, true, false, true, false},
+		{[]int{3, 2, 1}, false, false, false, true},
+		{[3]string{"a", "b", "a"}, false, false, false, false},
+		{[]time.Duration{time.Second, time.Minute}, true, true, true, false},
+	}
+
+	for _, c := range cases {
+		if IsSorted(mockT, c.list) != c.sorted {
+			t.Errorf("IsSorted(%#v) should return %v", c.list, c.sorted)
+		}
+		if IsIncreasing(mockT, c.list) != c.increasing {
+			t.Errorf("IsIncreasing(%#v) should return %v", c.list, c.increasing)
+		}
+		if IsNonDecreasing(mockT, c.list) != c.nonDecreasing {
+			t.Errorf("IsNonDecreasing(%#v) should return %v", c.list, c.nonDecreasing)
+		}
+		if IsDecreasing(mockT, c.list) != c.decreasing {
+			t.Errorf("IsDecreasing(%#v) should return %v", c.list, c.decreasing)
+		}
+	}
+
+	if !IsSorted(mockT, sort.StringSlice{"a", "b"}) || !IsSorted(mockT, sort.Reverse(sort.IntSlice{2, 1})) {
+		t.Error("IsSorted should use sort.Interface")
+	}
+	if IsSorted(mockT, sort.IntSlice{2, 1}) {
+		t.Error("IsSorted should return false")
+	}
+
+	bufT := new(bufferT)
+	IsIncreasing(bufT, []int{1, 2, 5, 2})
+	Contains(t, bufT.buf.String(), "Not increasing: [3] 2 is not greater than [2] 5")
+
+	bufT = new(bufferT)
+	IsDecreasing(bufT, []string{"b", "b"})
+	Contains(t, bufT.buf.String(), `Not decreasing: [1] "b" is not less than [0] "b"`)
+
+	bufT = new(bufferT)
+	IsSorted(bufT, sort.IntSlice{1, 3, 2})
+	Contains(t, bufT.buf.String(), "Not sorted: [2] is less than [1]")
+
+	bufT = new(bufferT)
+	IsNonDecreasing(bufT, "abc")
+	Contains(t, bufT.buf.String(), `"abc" is not an array or slice`)
+
+	bufT = new(bufferT)
+	IsIncreasing(bufT, []interface{}{1, "a"})
+	Contains(t, bufT.buf.String(), "Cannot compare string and int")
+
+}
+
+func TestIsSortedBy(t *testing.T) {
+
+	mockT := new(testing.T)
+
+	type event struct {
+		ID   int
+		Name string
+	}
+	byID := func(a, b event) bool { return a.ID < b.ID }
+
+	if !IsSortedBy(mockT, []event{{1, "a"}, {2, "b"}, {2, "c"}}, byID) {
+		t.Error("IsSortedBy should return true")
+	}
+	if IsSortedBy(mockT, []event{{2, "a"}, {1, "b"}}, byID) {
+		t.Error("IsSortedBy should return false")
+	}
+	if IsSortedBy(mockT, []int{1, 2}, byID) {
+		t.Error("IsSortedBy should return false for the wrong less func")
+	}
+	if IsSortedBy(mockT, 1, byID) {
+		t.Error("IsSortedBy should return false for a non-list")
+	}
+
+	bufT := new(bufferT)
+	IsSortedBy(bufT, []event{{1, "a"}, {3, "b"}, {2, "c"}}, byID)
+	Contains(t, bufT.buf.String(), `Not sorted: [2] assert.event{ID:2, Name:"c"} is less than [1] assert.event{ID:3, Name:"b"}`)
+
+	bufT = new(bufferT)
+	IsSortedBy(bufT, []int{1, 2}, byID)
+	Contains(t, bufT.buf.String(), "less must be of the form func(a, b int) bool, got func(assert.event, assert.event) bool")
+
+}
+
 func TestRegexp(t *testing.T) {
 	mockT := new(testing.T)
 
@@ -763,3 +1771,97 @@ func TestCallerInfoWithAutogeneratedFunctions(t *testing.T) {
 		testAutogeneratedFunction()
 	})
 }
+
+func Test_funcPackage(t *testing.T) {
+	cases := []struct {
+		function, pkg, name string
+	}{
+		{"hawx.me/code/assert.Equal", "hawx.me/code/assert", "Equal"},
+		{"hawx.me/code/assert.(*Wrapped).Equal", "hawx.me/code/assert", "(*Wrapped).Equal"},
+		{"example.com/assert/helpers.TestThing.func1", "example.com/assert/helpers", "TestThing.func1"},
+		{"main.main", "main", "main"},
+		{"example.com/sub%2ev2.Check", "example.com/sub.v2", "Check"},
+		{"example.com/sub%2ev2.(*T).Check.func1", "example.com/sub.v2", "(*T).Check.func1"},
+	}
+
+	for _, tc := range cases {
+		pkg, name := funcPackage(tc.function)
+		Equal(t, tc.pkg, pkg)
+		Equal(t, tc.name, name)
+	}
+}
+
+func TestCallerInfoHidesPackage(t *testing.T) {
+	mockT := new(bufferT)
+	Equal(mockT, 1, 2)
+
+	Contains(t, mockT.buf.String(), "Error Trace:\tassertions_test.go:")
+	NotContains(t, mockT.buf.String(), "assertions.go:")
+	NotContains(t, mockT.buf.String(), "helpers.go:")
+}
+
+func TestFailWithHelper(t *testing.T) {
+	mockT := new(helperT)
+	Equal(mockT, 1, 2, "some %s", "message")
+
+	Equal(t, 2, mockT.helpers, "Equal and Fail should both mark themselves as helpers")
+	NotContains(t, mockT.buf.String(), "\r")
+	True(t, strings.HasPrefix(mockT.buf.String(), "\n\tError Trace:\tassertions_test.go:"))
+	Contains(t, mockT.buf.String(), "\n\tError:\t\tNot equal: 1 (expected)")
+	Contains(t, mockT.buf.String(), "\n\tMessages:\tsome message\n")
+}
+
+func TestMessageFromMsgAndArgs(t *testing.T) {
+	called := false
+	lazy := func() string {
+		called = true
+		return "built %d"
+	}
+
+	cases := []struct {
+		msgAndArgs []interface{}
+		expected   string
+	}{
+		{nil, ""},
+		{[]interface{}{"100%"}, "100%"},
+		{[]interface{}{"case %d", 1}, "case 1"},
+		{[]interface{}{io.EOF}, "EOF"},
+		{[]interface{}{42}, "42"},
+		{[]interface{}{struct{ ID int }{1}, "a"}, "{ID:1} a"},
+		{[]interface{}{func() string { return "lazy" }}, "lazy"},
+		{[]interface{}{lazy, 2}, "built 2"},
+	}
+
+	for _, c := range cases {
+		Equal(t, c.expected, messageFromMsgAndArgs(c.msgAndArgs...))
+	}
+	True(t, called)
+}
+
+func TestFailWithMessages(t *testing.T) {
+	called := false
+	lazy := func() string {
+		called = true
+		return "expensive"
+	}
+
+	if !Equal(new(helperT), 1, 1, lazy) || called {
+		t.Error("a func() string message should not be called when the assertion passes")
+	}
+
+	mockT := new(helperT)
+	Equal(mockT, 1, 2, "unexpected status", KV{"url": "/users", "attempt": 2})
+	Contains(t, mockT.buf.String(), "\n\tMessages:\tunexpected status\n\t\t\tattempt: 2\n\t\t\turl:     /users\n")
+
+	mockT = new(helperT)
+	Equal(mockT, 1, 2, KV{"id": 1})
+	Contains(t, mockT.buf.String(), "\n\tMessages:\tid: 1\n")
+
+	mockT = new(helperT)
+	Equal(mockT, 1, 2, fmt.Errorf("wrapped: %w", io.EOF))
+	Contains(t, mockT.buf.String(), "\n\tMessages:\twrapped: EOF\n")
+
+	bufT := new(bufferT)
+	Equal(bufT, 1, 2, "status", KV{"id": 1})
+	Contains(t, bufT.buf.String(), "\r\tMessages:\tstatus\n\r\t\t\tid: 1\n")
+}
@@ -0,0 +1,901 @@
+package assert
+
+import "time"
+
+// Chain runs assertions against a Wrapped value one after another, skipping
+// the rest once one of them has failed. It is returned by Wrapped.Chain.
+//
+//    assert(resp).Chain().NotNil().Field("Status").Equal(200)
+type Chain struct {
+	w          *Wrapped
+	failed     bool
+	negateNext bool
+}
+
+// Chain returns a Chain of assertions against the 'actual' value.
+//
+//    assert(resp).Chain().NotNil().Field("Status").Equal(200)
+func (w *Wrapped) Chain() *Chain {
+	return &Chain{w: w}
+}
+
+// next returns the Wrapped value to run the next assertion against, negated if
+// Not was called.
+func (c *Chain) next() *Wrapped {
+	if c.negateNext {
+		c.negateNext = false
+		return c.w.Not()
+	}
+
+	return c.w
+}
+
+// Passed returns whether every assertion in the chain was successful.
+func (c *Chain) Passed() bool {
+	return !c.failed
+}
+
+// Not inverts the next assertion in the chain.
+//
+//    assert(name).Chain().NotEmpty().Not().Contains(" ")
+func (c *Chain) Not() *Chain {
+	c.negateNext = !c.negateNext
+
+	return c
+}
+
+// navigated continues the chain against w, a value navigated to from the
+// current one.
+func (c *Chain) navigated(w *Wrapped) *Chain {
+	c.w = w
+	c.failed = w.invalid
+
+	return c
+}
+
+// Field continues the chain against the named field of the 'actual' struct,
+// as for Wrapped.Field.
+//
+//    assert(resp).Chain().NotNil().Field("Status").Equal(200)
+func (c *Chain) Field(name string) *Chain {
+	if h, ok := c.w.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	if c.failed {
+		return c
+	}
+
+	return c.navigated(c.w.Field(name))
+}
+
+// Index continues the chain against an element of the 'actual' array, slice or
+// string, as for Wrapped.Index.
+//
+//    assert(resp).Chain().Field("Items").Len(2).Index(0).Field("Name").Equal("John")
+func (c *Chain) Index(i int) *Chain {
+	if h, ok := c.w.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	if c.failed {
+		return c
+	}
+
+	return c.navigated(c.w.Index(i))
+}
+
+// Key continues the chain against a value of the 'actual' map, as for
+// Wrapped.Key.
+//
+//    assert(headers).Chain().HasKey("Accept").Key("Accept").Contains("json")
+func (c *Chain) Key(key interface{}) *Chain {
+	if h, ok := c.w.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	if c.failed {
+		return c
+	}
+
+	return c.navigated(c.w.Key(key))
+}
+
+// Deref continues the chain against the value that the 'actual' pointer points
+// to, as for Wrapped.Deref.
+//
+//    assert(resp).Chain().Field("Body").Deref().Equal(Body{Name: "John"})
+func (c *Chain) Deref() *Chain {
+	if h, ok := c.w.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	if c.failed {
+		return c
+	}
+
+	return c.navigated(c.w.Deref())
+}
+
+// Call continues the chain against the result of calling a method of the
+// 'actual' value, as for Wrapped.Call.
+//
+//    assert(resp).Chain().Call("Header", "Content-Type").Equal("application/json")
+func (c *Chain) Call(method string, args ...interface{}) *Chain {
+	if h, ok := c.w.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	if c.failed {
+		return c
+	}
+
+	return c.navigated(c.w.Call(method, args...))
+}
+
+// Fail is Wrapped.Fail, skipped once the chain has failed.
+func (c *Chain) Fail(msgAndArgs ...interface{}) *Chain {
+	if h, ok := c.w.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	if !c.failed && !c.next().Fail(msgAndArgs...) {
+		c.failed = true
+	}
+
+	return c
+}
+
+// Condition is Wrapped.Condition, skipped once the chain has failed.
+func (c *Chain) Condition(msgAndArgs ...interface{}) *Chain {
+	if h, ok := c.w.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	if !c.failed && !c.next().Condition(msgAndArgs...) {
+		c.failed = true
+	}
+
+	return c
+}
+
+// Consistently is Wrapped.Consistently, skipped once the chain has failed.
+func (c *Chain) Consistently(waitFor time.Duration, msgAndArgs ...interface{}) *Chain {
+	if h, ok := c.w.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	if !c.failed && !c.next().Consistently(waitFor, msgAndArgs...) {
+		c.failed = true
+	}
+
+	return c
+}
+
+// Contains is Wrapped.Contains, skipped once the chain has failed.
+func (c *Chain) Contains(expected interface{}, msgAndArgs ...interface{}) *Chain {
+	if h, ok := c.w.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	if !c.failed && !c.next().Contains(expected, msgAndArgs...) {
+		c.failed = true
+	}
+
+	return c
+}
+
+// ElementsMatch is Wrapped.ElementsMatch, skipped once the chain has failed.
+func (c *Chain) ElementsMatch(expected interface{}, msgAndArgs ...interface{}) *Chain {
+	if h, ok := c.w.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	if !c.failed && !c.next().ElementsMatch(expected, msgAndArgs...) {
+		c.failed = true
+	}
+
+	return c
+}
+
+// Empty is Wrapped.Empty, skipped once the chain has failed.
+func (c *Chain) Empty(msgAndArgs ...interface{}) *Chain {
+	if h, ok := c.w.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	if !c.failed && !c.next().Empty(msgAndArgs...) {
+		c.failed = true
+	}
+
+	return c
+}
+
+// Equal is Wrapped.Equal, skipped once the chain has failed.
+func (c *Chain) Equal(expected interface{}, msgAndArgs ...interface{}) *Chain {
+	if h, ok := c.w.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	if !c.failed && !c.next().Equal(expected, msgAndArgs...) {
+		c.failed = true
+	}
+
+	return c
+}
+
+// EqualError is Wrapped.EqualError, skipped once the chain has failed.
+func (c *Chain) EqualError(errString string, msgAndArgs ...interface{}) *Chain {
+	if h, ok := c.w.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	if !c.failed && !c.next().EqualError(errString, msgAndArgs...) {
+		c.failed = true
+	}
+
+	return c
+}
+
+// EqualWith is Wrapped.EqualWith, skipped once the chain has failed.
+func (c *Chain) EqualWith(expected interface{}, msgAndArgs ...interface{}) *Chain {
+	if h, ok := c.w.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	if !c.failed && !c.next().EqualWith(expected, msgAndArgs...) {
+		c.failed = true
+	}
+
+	return c
+}
+
+// Equivalent is Wrapped.Equivalent, skipped once the chain has failed.
+func (c *Chain) Equivalent(expected interface{}, msgAndArgs ...interface{}) *Chain {
+	if h, ok := c.w.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	if !c.failed && !c.next().Equivalent(expected, msgAndArgs...) {
+		c.failed = true
+	}
+
+	return c
+}
+
+// Error is Wrapped.Error, skipped once the chain has failed.
+func (c *Chain) Error(msgAndArgs ...interface{}) *Chain {
+	if h, ok := c.w.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	if !c.failed && !c.next().Error(msgAndArgs...) {
+		c.failed = true
+	}
+
+	return c
+}
+
+// ErrorAs is Wrapped.ErrorAs, skipped once the chain has failed.
+func (c *Chain) ErrorAs(target interface{}, msgAndArgs ...interface{}) *Chain {
+	if h, ok := c.w.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	if !c.failed && !c.next().ErrorAs(target, msgAndArgs...) {
+		c.failed = true
+	}
+
+	return c
+}
+
+// ErrorContains is Wrapped.ErrorContains, skipped once the chain has failed.
+func (c *Chain) ErrorContains(contains string, msgAndArgs ...interface{}) *Chain {
+	if h, ok := c.w.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	if !c.failed && !c.next().ErrorContains(contains, msgAndArgs...) {
+		c.failed = true
+	}
+
+	return c
+}
+
+// ErrorIs is Wrapped.ErrorIs, skipped once the chain has failed.
+func (c *Chain) ErrorIs(target error, msgAndArgs ...interface{}) *Chain {
+	if h, ok := c.w.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	if !c.failed && !c.next().ErrorIs(target, msgAndArgs...) {
+		c.failed = true
+	}
+
+	return c
+}
+
+// Eventually is Wrapped.Eventually, skipped once the chain has failed.
+func (c *Chain) Eventually(waitFor time.Duration, msgAndArgs ...interface{}) *Chain {
+	if h, ok := c.w.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	if !c.failed && !c.next().Eventually(waitFor, msgAndArgs...) {
+		c.failed = true
+	}
+
+	return c
+}
+
+// EventuallyWithT is Wrapped.EventuallyWithT, skipped once the chain has failed.
+func (c *Chain) EventuallyWithT(waitFor time.Duration, msgAndArgs ...interface{}) *Chain {
+	if h, ok := c.w.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	if !c.failed && !c.next().EventuallyWithT(waitFor, msgAndArgs...) {
+		c.failed = true
+	}
+
+	return c
+}
+
+// Exactly is Wrapped.Exactly, skipped once the chain has failed.
+func (c *Chain) Exactly(expected interface{}, msgAndArgs ...interface{}) *Chain {
+	if h, ok := c.w.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	if !c.failed && !c.next().Exactly(expected, msgAndArgs...) {
+		c.failed = true
+	}
+
+	return c
+}
+
+// False is Wrapped.False, skipped once the chain has failed.
+func (c *Chain) False(msgAndArgs ...interface{}) *Chain {
+	if h, ok := c.w.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	if !c.failed && !c.next().False(msgAndArgs...) {
+		c.failed = true
+	}
+
+	return c
+}
+
+// Greater is Wrapped.Greater, skipped once the chain has failed.
+func (c *Chain) Greater(e2 interface{}, msgAndArgs ...interface{}) *Chain {
+	if h, ok := c.w.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	if !c.failed && !c.next().Greater(e2, msgAndArgs...) {
+		c.failed = true
+	}
+
+	return c
+}
+
+// GreaterOrEqual is Wrapped.GreaterOrEqual, skipped once the chain has failed.
+func (c *Chain) GreaterOrEqual(e2 interface{}, msgAndArgs ...interface{}) *Chain {
+	if h, ok := c.w.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	if !c.failed && !c.next().GreaterOrEqual(e2, msgAndArgs...) {
+		c.failed = true
+	}
+
+	return c
+}
+
+// HasEntry is Wrapped.HasEntry, skipped once the chain has failed.
+func (c *Chain) HasEntry(key, expected interface{}, msgAndArgs ...interface{}) *Chain {
+	if h, ok := c.w.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	if !c.failed && !c.next().HasEntry(key, expected, msgAndArgs...) {
+		c.failed = true
+	}
+
+	return c
+}
+
+// HasKey is Wrapped.HasKey, skipped once the chain has failed.
+func (c *Chain) HasKey(key interface{}, msgAndArgs ...interface{}) *Chain {
+	if h, ok := c.w.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	if !c.failed && !c.next().HasKey(key, msgAndArgs...) {
+		c.failed = true
+	}
+
+	return c
+}
+
+// Implements is Wrapped.Implements, skipped once the chain has failed.
+func (c *Chain) Implements(iface interface{}, msgAndArgs ...interface{}) *Chain {
+	if h, ok := c.w.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	if !c.failed && !c.next().Implements(iface, msgAndArgs...) {
+		c.failed = true
+	}
+
+	return c
+}
+
+// InDelta is Wrapped.InDelta, skipped once the chain has failed.
+func (c *Chain) InDelta(expected interface{}, delta float64, msgAndArgs ...interface{}) *Chain {
+	if h, ok := c.w.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	if !c.failed && !c.next().InDelta(expected, delta, msgAndArgs...) {
+		c.failed = true
+	}
+
+	return c
+}
+
+// InEpsilon is Wrapped.InEpsilon, skipped once the chain has failed.
+func (c *Chain) InEpsilon(expected interface{}, epsilon float64, msgAndArgs ...interface{}) *Chain {
+	if h, ok := c.w.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	if !c.failed && !c.next().InEpsilon(expected, epsilon, msgAndArgs...) {
+		c.failed = true
+	}
+
+	return c
+}
+
+// IsDecreasing is Wrapped.IsDecreasing, skipped once the chain has failed.
+func (c *Chain) IsDecreasing(msgAndArgs ...interface{}) *Chain {
+	if h, ok := c.w.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	if !c.failed && !c.next().IsDecreasing(msgAndArgs...) {
+		c.failed = true
+	}
+
+	return c
+}
+
+// IsIncreasing is Wrapped.IsIncreasing, skipped once the chain has failed.
+func (c *Chain) IsIncreasing(msgAndArgs ...interface{}) *Chain {
+	if h, ok := c.w.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	if !c.failed && !c.next().IsIncreasing(msgAndArgs...) {
+		c.failed = true
+	}
+
+	return c
+}
+
+// IsNonDecreasing is Wrapped.IsNonDecreasing, skipped once the chain has failed.
+func (c *Chain) IsNonDecreasing(msgAndArgs ...interface{}) *Chain {
+	if h, ok := c.w.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	if !c.failed && !c.next().IsNonDecreasing(msgAndArgs...) {
+		c.failed = true
+	}
+
+	return c
+}
+
+// IsSorted is Wrapped.IsSorted, skipped once the chain has failed.
+func (c *Chain) IsSorted(msgAndArgs ...interface{}) *Chain {
+	if h, ok := c.w.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	if !c.failed && !c.next().IsSorted(msgAndArgs...) {
+		c.failed = true
+	}
+
+	return c
+}
+
+// IsSortedBy is Wrapped.IsSortedBy, skipped once the chain has failed.
+func (c *Chain) IsSortedBy(less interface{}, msgAndArgs ...interface{}) *Chain {
+	if h, ok := c.w.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	if !c.failed && !c.next().IsSortedBy(less, msgAndArgs...) {
+		c.failed = true
+	}
+
+	return c
+}
+
+// IsType is Wrapped.IsType, skipped once the chain has failed.
+func (c *Chain) IsType(expected interface{}, msgAndArgs ...interface{}) *Chain {
+	if h, ok := c.w.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	if !c.failed && !c.next().IsType(expected, msgAndArgs...) {
+		c.failed = true
+	}
+
+	return c
+}
+
+// Len is Wrapped.Len, skipped once the chain has failed.
+func (c *Chain) Len(length int, msgAndArgs ...interface{}) *Chain {
+	if h, ok := c.w.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	if !c.failed && !c.next().Len(length, msgAndArgs...) {
+		c.failed = true
+	}
+
+	return c
+}
+
+// Less is Wrapped.Less, skipped once the chain has failed.
+func (c *Chain) Less(e2 interface{}, msgAndArgs ...interface{}) *Chain {
+	if h, ok := c.w.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	if !c.failed && !c.next().Less(e2, msgAndArgs...) {
+		c.failed = true
+	}
+
+	return c
+}
+
+// LessOrEqual is Wrapped.LessOrEqual, skipped once the chain has failed.
+func (c *Chain) LessOrEqual(e2 interface{}, msgAndArgs ...interface{}) *Chain {
+	if h, ok := c.w.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	if !c.failed && !c.next().LessOrEqual(e2, msgAndArgs...) {
+		c.failed = true
+	}
+
+	return c
+}
+
+// Matches is Wrapped.Matches, skipped once the chain has failed.
+func (c *Chain) Matches(matcher Matcher, msgAndArgs ...interface{}) *Chain {
+	if h, ok := c.w.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	if !c.failed && !c.next().Matches(matcher, msgAndArgs...) {
+		c.failed = true
+	}
+
+	return c
+}
+
+// Negative is Wrapped.Negative, skipped once the chain has failed.
+func (c *Chain) Negative(msgAndArgs ...interface{}) *Chain {
+	if h, ok := c.w.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	if !c.failed && !c.next().Negative(msgAndArgs...) {
+		c.failed = true
+	}
+
+	return c
+}
+
+// Never is Wrapped.Never, skipped once the chain has failed.
+func (c *Chain) Never(waitFor time.Duration, msgAndArgs ...interface{}) *Chain {
+	if h, ok := c.w.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	if !c.failed && !c.next().Never(waitFor, msgAndArgs...) {
+		c.failed = true
+	}
+
+	return c
+}
+
+// Nil is Wrapped.Nil, skipped once the chain has failed.
+func (c *Chain) Nil(msgAndArgs ...interface{}) *Chain {
+	if h, ok := c.w.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	if !c.failed && !c.next().Nil(msgAndArgs...) {
+		c.failed = true
+	}
+
+	return c
+}
+
+// NoError is Wrapped.NoError, skipped once the chain has failed.
+func (c *Chain) NoError(msgAndArgs ...interface{}) *Chain {
+	if h, ok := c.w.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	if !c.failed && !c.next().NoError(msgAndArgs...) {
+		c.failed = true
+	}
+
+	return c
+}
+
+// NotContains is Wrapped.NotContains, skipped once the chain has failed.
+func (c *Chain) NotContains(expected interface{}, msgAndArgs ...interface{}) *Chain {
+	if h, ok := c.w.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	if !c.failed && !c.next().NotContains(expected, msgAndArgs...) {
+		c.failed = true
+	}
+
+	return c
+}
+
+// NotEmpty is Wrapped.NotEmpty, skipped once the chain has failed.
+func (c *Chain) NotEmpty(msgAndArgs ...interface{}) *Chain {
+	if h, ok := c.w.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	if !c.failed && !c.next().NotEmpty(msgAndArgs...) {
+		c.failed = true
+	}
+
+	return c
+}
+
+// NotEqual is Wrapped.NotEqual, skipped once the chain has failed.
+func (c *Chain) NotEqual(expected interface{}, msgAndArgs ...interface{}) *Chain {
+	if h, ok := c.w.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	if !c.failed && !c.next().NotEqual(expected, msgAndArgs...) {
+		c.failed = true
+	}
+
+	return c
+}
+
+// NotErrorIs is Wrapped.NotErrorIs, skipped once the chain has failed.
+func (c *Chain) NotErrorIs(target error, msgAndArgs ...interface{}) *Chain {
+	if h, ok := c.w.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	if !c.failed && !c.next().NotErrorIs(target, msgAndArgs...) {
+		c.failed = true
+	}
+
+	return c
+}
+
+// NotHasKey is Wrapped.NotHasKey, skipped once the chain has failed.
+func (c *Chain) NotHasKey(key interface{}, msgAndArgs ...interface{}) *Chain {
+	if h, ok := c.w.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	if !c.failed && !c.next().NotHasKey(key, msgAndArgs...) {
+		c.failed = true
+	}
+
+	return c
+}
+
+// NotNil is Wrapped.NotNil, skipped once the chain has failed.
+func (c *Chain) NotNil(msgAndArgs ...interface{}) *Chain {
+	if h, ok := c.w.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	if !c.failed && !c.next().NotNil(msgAndArgs...) {
+		c.failed = true
+	}
+
+	return c
+}
+
+// NotPanics is Wrapped.NotPanics, skipped once the chain has failed.
+func (c *Chain) NotPanics(msgAndArgs ...interface{}) *Chain {
+	if h, ok := c.w.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	if !c.failed && !c.next().NotPanics(msgAndArgs...) {
+		c.failed = true
+	}
+
+	return c
+}
+
+// NotRegexp is Wrapped.NotRegexp, skipped once the chain has failed.
+func (c *Chain) NotRegexp(regex interface{}, msgAndArgs ...interface{}) *Chain {
+	if h, ok := c.w.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	if !c.failed && !c.next().NotRegexp(regex, msgAndArgs...) {
+		c.failed = true
+	}
+
+	return c
+}
+
+// NotSubset is Wrapped.NotSubset, skipped once the chain has failed.
+func (c *Chain) NotSubset(subset interface{}, msgAndArgs ...interface{}) *Chain {
+	if h, ok := c.w.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	if !c.failed && !c.next().NotSubset(subset, msgAndArgs...) {
+		c.failed = true
+	}
+
+	return c
+}
+
+// NotZero is Wrapped.NotZero, skipped once the chain has failed.
+func (c *Chain) NotZero(msgAndArgs ...interface{}) *Chain {
+	if h, ok := c.w.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	if !c.failed && !c.next().NotZero(msgAndArgs...) {
+		c.failed = true
+	}
+
+	return c
+}
+
+// Panics is Wrapped.Panics, skipped once the chain has failed.
+func (c *Chain) Panics(msgAndArgs ...interface{}) *Chain {
+	if h, ok := c.w.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	if !c.failed && !c.next().Panics(msgAndArgs...) {
+		c.failed = true
+	}
+
+	return c
+}
+
+// PanicsMatching is Wrapped.PanicsMatching, skipped once the chain has failed.
+func (c *Chain) PanicsMatching(rx interface{}, msgAndArgs ...interface{}) *Chain {
+	if h, ok := c.w.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	if !c.failed && !c.next().PanicsMatching(rx, msgAndArgs...) {
+		c.failed = true
+	}
+
+	return c
+}
+
+// PanicsWithError is Wrapped.PanicsWithError, skipped once the chain has failed.
+func (c *Chain) PanicsWithError(expected interface{}, msgAndArgs ...interface{}) *Chain {
+	if h, ok := c.w.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	if !c.failed && !c.next().PanicsWithError(expected, msgAndArgs...) {
+		c.failed = true
+	}
+
+	return c
+}
+
+// PanicsWithValue is Wrapped.PanicsWithValue, skipped once the chain has failed.
+func (c *Chain) PanicsWithValue(expected interface{}, msgAndArgs ...interface{}) *Chain {
+	if h, ok := c.w.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	if !c.failed && !c.next().PanicsWithValue(expected, msgAndArgs...) {
+		c.failed = true
+	}
+
+	return c
+}
+
+// Positive is Wrapped.Positive, skipped once the chain has failed.
+func (c *Chain) Positive(msgAndArgs ...interface{}) *Chain {
+	if h, ok := c.w.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	if !c.failed && !c.next().Positive(msgAndArgs...) {
+		c.failed = true
+	}
+
+	return c
+}
+
+// Regexp is Wrapped.Regexp, skipped once the chain has failed.
+func (c *Chain) Regexp(regex interface{}, msgAndArgs ...interface{}) *Chain {
+	if h, ok := c.w.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	if !c.failed && !c.next().Regexp(regex, msgAndArgs...) {
+		c.failed = true
+	}
+
+	return c
+}
+
+// Subset is Wrapped.Subset, skipped once the chain has failed.
+func (c *Chain) Subset(subset interface{}, msgAndArgs ...interface{}) *Chain {
+	if h, ok := c.w.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	if !c.failed && !c.next().Subset(subset, msgAndArgs...) {
+		c.failed = true
+	}
+
+	return c
+}
+
+// True is Wrapped.True, skipped once the chain has failed.
+func (c *Chain) True(msgAndArgs ...interface{}) *Chain {
+	if h, ok := c.w.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	if !c.failed && !c.next().True(msgAndArgs...) {
+		c.failed = true
+	}
+
+	return c
+}
+
+// WithinDuration is Wrapped.WithinDuration, skipped once the chain has failed.
+func (c *Chain) WithinDuration(expected time.Time, delta time.Duration, msgAndArgs ...interface{}) *Chain {
+	if h, ok := c.w.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	if !c.failed && !c.next().WithinDuration(expected, delta, msgAndArgs...) {
+		c.failed = true
+	}
+
+	return c
+}
+
+// Zero is Wrapped.Zero, skipped once the chain has failed.
+func (c *Chain) Zero(msgAndArgs ...interface{}) *Chain {
+	if h, ok := c.w.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	if !c.failed && !c.next().Zero(msgAndArgs...) {
+		c.failed = true
+	}
+
+	return c
+}
@@ -0,0 +1,66 @@
+package assert
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestChain(t *testing.T) {
+	resp := &wrappedResponse{Status: 200, Body: &wrappedBody{Name: "John"}}
+	bufT := new(helperT)
+	assert := Wrap(bufT)
+
+	if !assert(resp).Chain().NotNil().Field("Status").Equal(200).Not().Equal(500).Passed() {
+		t.Error("Chain should pass when every assertion passes")
+	}
+	Equal(t, "", bufT.buf.String())
+
+	if assert(resp).Chain().NotNil().Field("Status").Equal(500).Equal(501).Passed() {
+		t.Error("Chain should not pass when an assertion fails")
+	}
+	Equal(t, 1, strings.Count(bufT.buf.String(), "Error Trace:"))
+	Contains(t, bufT.buf.String(), "Path:\t\t.Status")
+
+	bufT = new(helperT)
+	if Wrap(bufT)(nil).Chain().NotNil().Field("Status").Equal(200).Passed() {
+		t.Error("Chain should not pass when an assertion fails")
+	}
+	Equal(t, 1, strings.Count(bufT.buf.String(), "Error Trace:"))
+	NotContains(t, bufT.buf.String(), "Field Status")
+
+	bufT = new(helperT)
+	if Wrap(bufT)(resp).Chain().Field("Missing").Equal(1).Passed() {
+		t.Error("Chain should not pass when Field fails")
+	}
+	Equal(t, 1, strings.Count(bufT.buf.String(), "Error Trace:"))
+}
+
+func TestChainNavigation(t *testing.T) {
+	resp := &wrappedResponse{
+		Body:    &wrappedBody{Name: "John"},
+		Items:   []wrappedBody{{Name: "John"}},
+		Headers: map[string]string{"Accept": "application/json"},
+	}
+	bufT := new(helperT)
+	assert := Wrap(bufT)
+
+	if !assert(resp).Chain().Field("Items").Len(1).Index(0).Field("Name").Equal("John").Passed() {
+		t.Error("Chain should navigate with Index")
+	}
+	if !assert(resp).Chain().Field("Headers").HasKey("Accept").Key("Accept").Contains("json").Passed() {
+		t.Error("Chain should navigate with Key")
+	}
+	if !assert(resp).Chain().Field("Body").Deref().Field("Name").Equal("John").Passed() {
+		t.Error("Chain should navigate with Deref")
+	}
+	if !assert(resp).Chain().Call("Header", "Accept").Equal("application/json").Passed() {
+		t.Error("Chain should navigate with Call")
+	}
+	Equal(t, "", bufT.buf.String())
+
+	if assert(resp).Chain().Field("Items").Len(2).Index(1).Field("Name").Equal("Jane").Passed() {
+		t.Error("Chain should not pass when an assertion fails")
+	}
+	Equal(t, 1, strings.Count(bufT.buf.String(), "Error Trace:"))
+	Contains(t, bufT.buf.String(), "Path:\t\t.Items")
+}
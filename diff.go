@@ -0,0 +1,461 @@
+package assert
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// maxDiffs is the number of differences reported before the rest are
+// summarised.
+const maxDiffs = 20
+
+// visit records a pair of references that have already been compared, so that
+// cyclic structures terminate.
+type visit struct {
+	expected, actual uintptr
+	typ              reflect.Type
+}
+
+// differ walks two values reflectively in the same way as reflect.DeepEqual,
+// optionally recording the path to each difference it finds.
+type differ struct {
+	// report is set when differences should be recorded, when false the walk
+	// stops at the first difference.
+	report  bool
+	diffs   []string
+	visited map[visit]bool
+	options equalOptions
+}
+
+func newDiffer(report bool, opts ...EqualOption) *differ {
+	d := &differ{
+		report:  report,
+		visited: map[visit]bool{},
+	}
+	for _, opt := range opts {
+		opt(&d.options)
+	}
+	return d
+}
+
+// done returns true if no more of the values need to be walked.
+func (d *differ) done() bool {
+	return !d.report && len(d.diffs) > 0
+}
+
+func (d *differ) add(path, format string, args ...interface{}) bool {
+	if path == "" {
+		path = "."
+	}
+	d.diffs = append(d.diffs, path+": "+fmt.Sprintf(format, args...))
+	return false
+}
+
+func (d *differ) mismatch(path string, expected, actual reflect.Value) bool {
+	return d.add(path, "%s != %s", formatValue(expected), formatValue(actual))
+}
+
+// walk compares expected and actual, returning true if they are deeply equal.
+// When expected is a Matcher, actual is matched against it instead, unless
+// actual is a Matcher too, in which case the two are compared like any other
+// values.
+func (d *differ) walk(path string, expected, actual reflect.Value) bool {
+	if m, ok := matcherOf(expected); ok && !isMatcher(actual) {
+		var value interface{}
+		if actual.IsValid() && actual.CanInterface() {
+			value = actual.Interface()
+		}
+		if !m.Match(value) {
+			return d.add(path, "expected %s, but %s", m.Describe(), m.DescribeMismatch(value))
+		}
+		return true
+	}
+
+	if !expected.IsValid() || !actual.IsValid() {
+		if expected.IsValid() == actual.IsValid() {
+			return true
+		}
+		return d.mismatch(path, expected, actual)
+	}
+
+	if expected.Type() != actual.Type() {
+		return d.add(path, "type %v != %v", expected.Type(), actual.Type())
+	}
+
+	if equal, ok := d.options.compare(expected, actual); ok {
+		if !equal {
+			return d.mismatch(path, expected, actual)
+		}
+		return true
+	}
+
+	switch expected.Kind() {
+	case reflect.Map, reflect.Slice, reflect.Ptr:
+		if d.options.equateEmpty && expected.Kind() != reflect.Ptr &&
+			expected.Len() == 0 && actual.Len() == 0 {
+			return true
+		}
+		if expected.IsNil() != actual.IsNil() {
+			return d.mismatch(path, expected, actual)
+		}
+		if expected.Pointer() == actual.Pointer() &&
+			(expected.Kind() != reflect.Slice || expected.Len() == actual.Len()) {
+			return true
+		}
+
+		v := visit{expected.Pointer(), actual.Pointer(), expected.Type()}
+		if d.visited[v] {
+			return true
+		}
+		d.visited[v] = true
+	}
+
+	switch expected.Kind() {
+	case reflect.Array:
+		return d.walkElements(path, expected, actual)
+
+	case reflect.Slice:
+		return d.walkElements(path, expected, actual)
+
+	case reflect.Interface:
+		if expected.IsNil() || actual.IsNil() {
+			if expected.IsNil() == actual.IsNil() {
+				return true
+			}
+			return d.mismatch(path, expected, actual)
+		}
+		return d.walk(path, expected.Elem(), actual.Elem())
+
+	case reflect.Ptr:
+		return d.walk(path, expected.Elem(), actual.Elem())
+
+	case reflect.Struct:
+		equal := true
+		for i := 0; i < expected.NumField() && !d.done(); i++ {
+			field := expected.Type().Field(i)
+			if d.options.ignoreField(field) {
+				continue
+			}
+			if !d.walk(path+"."+field.Name, expected.Field(i), actual.Field(i)) {
+				equal = false
+			}
+		}
+		return equal
+
+	case reflect.Map:
+		return d.walkMap(path, expected, actual)
+
+	case reflect.Func:
+		if expected.IsNil() && actual.IsNil() {
+			return true
+		}
+		return d.add(path, "func values are only equal if both are nil")
+
+	default:
+		if !scalarsAreEqual(expected, actual) {
+			return d.mismatch(path, expected, actual)
+		}
+		return true
+	}
+}
+
+func (d *differ) walkElements(path string, expected, actual reflect.Value) bool {
+	equal := true
+	if expected.Len() != actual.Len() {
+		equal = d.add(path, "length %d != %d", expected.Len(), actual.Len())
+	}
+
+	for i := 0; i < expected.Len() || i < actual.Len(); i++ {
+		if d.done() {
+			break
+		}
+
+		elemPath := fmt.Sprintf("%s[%d]", path, i)
+		switch {
+		case i >= actual.Len():
+			equal = d.add(elemPath, "missing %s", formatValue(expected.Index(i)))
+		case i >= expected.Len():
+			equal = d.add(elemPath, "unexpected %s", formatValue(actual.Index(i)))
+		default:
+			if !d.walk(elemPath, expected.Index(i), actual.Index(i)) {
+				equal = false
+			}
+		}
+	}
+
+	return equal
+}
+
+func (d *differ) walkMap(path string, expected, actual reflect.Value) bool {
+	equal := true
+
+	for _, key := range sortedKeys(expected) {
+		if d.done() {
+			break
+		}
+
+		keyPath := fmt.Sprintf("%s[%s]", path, formatValue(key))
+		actualValue := actual.MapIndex(key)
+		if !actualValue.IsValid() {
+			equal = d.add(keyPath, "missing %s", formatValue(expected.MapIndex(key)))
+		} else if !d.walk(keyPath, expected.MapIndex(key), actualValue) {
+			equal = false
+		}
+	}
+
+	for _, key := range sortedKeys(actual) {
+		if d.done() {
+			break
+		}
+
+		if !expected.MapIndex(key).IsValid() {
+			keyPath := fmt.Sprintf("%s[%s]", path, formatValue(key))
+			equal = d.add(keyPath, "unexpected %s", formatValue(actual.MapIndex(key)))
+		}
+	}
+
+	return equal
+}
+
+// scalarsAreEqual compares two values of the same basic kind, without needing
+// to call Interface so that unexported fields can be compared.
+func scalarsAreEqual(expected, actual reflect.Value) bool {
+	switch expected.Kind() {
+	case reflect.Bool:
+		return expected.Bool() == actual.Bool()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return expected.Int() == actual.Int()
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return expected.Uint() == actual.Uint()
+	case reflect.Float32, reflect.Float64:
+		return expected.Float() == actual.Float()
+	case reflect.Complex64, reflect.Complex128:
+		return expected.Complex() == actual.Complex()
+	case reflect.String:
+		return expected.String() == actual.String()
+	case reflect.Chan, reflect.UnsafePointer:
+		return expected.Pointer() == actual.Pointer()
+	}
+
+	return false
+}
+
+// sortedKeys returns the keys of a map in a stable order for reporting.
+func sortedKeys(m reflect.Value) []reflect.Value {
+	keys := m.MapKeys()
+	sort.Slice(keys, func(i, j int) bool {
+		return formatValue(keys[i]) < formatValue(keys[j])
+	})
+	return keys
+}
+
+func formatValue(v reflect.Value) string {
+	if !v.IsValid() {
+		return "nil"
+	}
+	return fmt.Sprintf("%#v", v)
+}
+
+// diffValues returns a line for each path at which expected and actual
+// differ.
+func diffValues(expected, actual interface{}, opts ...EqualOption) []string {
+	d := newDiffer(true, opts...)
+	d.walk("", reflect.ValueOf(expected), reflect.ValueOf(actual))
+	return d.diffs
+}
+
+// isStructured returns true for values that are better described by a diff
+// than by printing them in full.
+func isStructured(object interface{}) bool {
+	if object == nil {
+		return false
+	}
+
+	t := reflect.TypeOf(object)
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+
+	switch t.Kind() {
+	case reflect.Struct, reflect.Map, reflect.Slice, reflect.Array:
+		return true
+	}
+
+	return false
+}
+
+// equalFailureMessage describes how expected and actual differ, as a hexdump
+// for binary data, a line diff for multi-line text or a list of paths for
+// structured values of the same type.
+func equalFailureMessage(expected, actual interface{}, opts ...EqualOption) string {
+	if matcher, ok := expected.(Matcher); ok && !isMatcher(reflect.ValueOf(actual)) {
+		return matcherFailureMessage(matcher, actual)
+	}
+
+	if e, a, ok := isBinary(expected, actual); ok {
+		if diff := hexDiff(e, a); diff != "" {
+			return "Not equal (expected != actual):\n" + diff
+		}
+	}
+
+	if e, a, ok := isMultiline(expected, actual); ok {
+		if diff, ok := unifiedDiff(e, a); ok {
+			return "Not equal (expected != actual):\n" + diff
+		}
+	}
+
+	if isStructured(expected) && reflect.TypeOf(expected) == reflect.TypeOf(actual) {
+		if diffs := diffValues(expected, actual, opts...); len(diffs) > 0 {
+			return "Not equal (expected != actual):\n" + formatDiffs(diffs)
+		}
+	}
+
+	if reflect.TypeOf(expected) != reflect.TypeOf(actual) &&
+		fmt.Sprintf("%#v", expected) == fmt.Sprintf("%#v", actual) {
+		return fmt.Sprintf("Not equal: %#v (expected %T)\n"+
+			"        != %#v (actual %T)", expected, expected, actual, actual)
+	}
+
+	return fmt.Sprintf("Not equal: %#v (expected)\n"+
+		"        != %#v (actual)", expected, actual)
+}
+
+// equivalentFailureMessage is the same as equalFailureMessage, but first
+// converts expected to the type of actual where possible.
+func equivalentFailureMessage(expected, actual interface{}) string {
+	if expected != nil && actual != nil {
+		actualType := reflect.TypeOf(actual)
+		expectedValue := reflect.ValueOf(expected)
+		if expectedValue.Type().ConvertibleTo(actualType) {
+			return equalFailureMessage(expectedValue.Convert(actualType).Interface(), actual)
+		}
+	}
+
+	return equalFailureMessage(expected, actual)
+}
+
+func formatDiffs(diffs []string) string {
+	more := 0
+	if len(diffs) > maxDiffs {
+		more = len(diffs) - maxDiffs
+		diffs = diffs[:maxDiffs]
+	}
+
+	lines := make([]string, len(diffs))
+	for i, diff := range diffs {
+		lines[i] = "    " + diff
+	}
+	if more > 0 {
+		lines = append(lines, fmt.Sprintf("    ... and %d more", more))
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// elementCount is a value and the number of times it appears.
+type elementCount struct {
+	value reflect.Value
+	count int
+}
+
+// countElements groups equal values together, keeping the order in which they
+// first appear.
+func countElements(values []reflect.Value) []elementCount {
+	var counts []elementCount
+
+outer:
+	for _, value := range values {
+		for i := range counts {
+			if objectsAreEqual(counts[i].value.Interface(), value.Interface()) {
+				counts[i].count++
+				continue outer
+			}
+		}
+		counts = append(counts, elementCount{value: value, count: 1})
+	}
+
+	return counts
+}
+
+// diffElements compares the elements of two slices or arrays ignoring their
+// order, returning a line for each value that is missing from actual or extra
+// in actual.
+func diffElements(expected, actual reflect.Value) []string {
+	matched := make([]bool, actual.Len())
+	var missing, extra []reflect.Value
+
+outer:
+	for i := 0; i < expected.Len(); i++ {
+		for j := 0; j < actual.Len(); j++ {
+			if !matched[j] && objectsAreEqual(expected.Index(i).Interface(), actual.Index(j).Interface()) {
+				matched[j] = true
+				continue outer
+			}
+		}
+		missing = append(missing, expected.Index(i))
+	}
+
+	for j := 0; j < actual.Len(); j++ {
+		if !matched[j] {
+			extra = append(extra, actual.Index(j))
+		}
+	}
+
+	var diffs []string
+	for _, c := range countElements(missing) {
+		diffs = append(diffs, fmt.Sprintf("missing %d × %s", c.count, formatValue(c.value)))
+	}
+	for _, c := range countElements(extra) {
+		diffs = append(diffs, fmt.Sprintf("extra %d × %s", c.count, formatValue(c.value)))
+	}
+
+	return diffs
+}
+
+// diffSubset returns a line for each element of subset that is not in list, or
+// when both are maps each entry of subset that is not in list. It returns ok as
+// false when list and subset are not both lists or both maps.
+func diffSubset(list, subset reflect.Value) (diffs []string, ok bool) {
+	switch {
+	case isList(list) && isList(subset):
+		for i := 0; i < subset.Len(); i++ {
+			if _, found := includeElement(list.Interface(), subset.Index(i).Interface()); !found {
+				diffs = append(diffs, fmt.Sprintf("missing %s", formatValue(subset.Index(i))))
+			}
+		}
+
+		return diffs, true
+
+	case list.Kind() == reflect.Map && subset.Kind() == reflect.Map:
+		d := newDiffer(true)
+		for _, key := range sortedKeys(subset) {
+			if d.done() {
+				break
+			}
+
+			keyPath := fmt.Sprintf("[%s]", formatValue(key))
+			value, found := mapIndex(list, key)
+			if !found {
+				d.add(keyPath, "missing %s", formatValue(subset.MapIndex(key)))
+			} else {
+				d.walk(keyPath, elem(subset.MapIndex(key)), elem(value))
+			}
+		}
+
+		return d.diffs, true
+	}
+
+	return nil, false
+}
+
+// elem returns the value held by a non-nil interface, so that values stored in
+// maps of different types can be compared.
+func elem(v reflect.Value) reflect.Value {
+	if v.Kind() == reflect.Interface && !v.IsNil() {
+		return v.Elem()
+	}
+
+	return v
+}
@@ -0,0 +1,85 @@
+package assert
+
+import (
+	"strings"
+	"testing"
+)
+
+type diffAddress struct {
+	Zip string
+}
+
+type diffUser struct {
+	Name    string
+	Address *diffAddress
+	Tags    []string
+	age     int
+}
+
+type diffGroup struct {
+	Users []diffUser
+	Meta  map[string]int
+}
+
+func TestDiffValues(t *testing.T) {
+	expected := diffGroup{
+		Users: []diffUser{
+			{Name: "a", Address: &diffAddress{"123"}, Tags: []string{"x"}, age: 1},
+			{Name: "b", Address: &diffAddress{"456"}},
+		},
+		Meta: map[string]int{"one": 1, "two": 2},
+	}
+	actual := diffGroup{
+		Users: []diffUser{
+			{Name: "a", Address: &diffAddress{"124"}, Tags: []string{"x", "y"}, age: 2},
+			{Name: "b", Address: nil},
+		},
+		Meta: map[string]int{"one": 1, "three": 3},
+	}
+
+	Equal(t, []string{
+		`.Users[0].Address.Zip: "123" != "124"`,
+		`.Users[0].Tags: length 1 != 2`,
+		`.Users[0].Tags[1]: unexpected "y"`,
+		`.Users[0].age: 1 != 2`,
+		`.Users[1].Address: &assert.diffAddress{Zip:"456"} != (*assert.diffAddress)(nil)`,
+		`.Meta["two"]: missing 2`,
+		`.Meta["three"]: unexpected 3`,
+	}, diffValues(expected, actual))
+
+	Equal(t, []string{`.: length 2 != 1`, `[1]: missing 2`}, diffValues([]int{1, 2}, []int{1}))
+	Empty(t, diffValues(expected, expected))
+}
+
+func TestDiffValuesCycles(t *testing.T) {
+	type node struct {
+		Next  *node
+		Value int
+	}
+
+	a := &node{Value: 1}
+	a.Next = a
+	b := &node{Value: 1}
+	b.Next = b
+
+	True(t, objectsAreEqual(a, b))
+	b.Value = 2
+	False(t, objectsAreEqual(a, b))
+}
+
+func TestEqualReportsDiff(t *testing.T) {
+	mockT := new(bufferT)
+
+	Equal(mockT, diffUser{Name: "a"}, diffUser{Name: "b"})
+	Contains(t, mockT.buf.String(), `.Name: "a" != "b"`)
+	False(t, strings.Contains(mockT.buf.String(), "(expected)"))
+
+	mockT = new(bufferT)
+	Equal(mockT, 1, 2)
+	Contains(t, mockT.buf.String(), "Not equal: 1 (expected)")
+
+	mockT = new(bufferT)
+	type ints []int
+	Equivalent(mockT, ints{1, 2}, []int{1, 3})
+	Contains(t, mockT.buf.String(), "[1]: 2 != 3")
+}
@@ -41,5 +41,60 @@ the correct place.
 
 Every assertion function also takes an optional string message as the final argument,
 allowing custom error messages to be appended to the message the assertion method outputs.
+The message can also be any other value, or a func() string that is only called if the
+assertion fails, and can be followed by a KV to show as a table:
+
+   assert.Equal(t, 200, resp.StatusCode, "fetching %s", url, assert.KV{"body": body})
+
+WithContext, on a TestingT, Assertions or the function returned by Wrap, adds a
+label to the messages of every failure, which is useful in table-driven tests:
+
+   assert := assert.Wrap(t).WithContext("case %d: %s", i, tc.name)
+
+Typed Assertions
+
+EqualT, NotEqualT, ContainsT and ElementsOf take type parameters, so that comparing
+values of different types, such as int64 and int, is a compile error instead of a
+failure. WrapT does the same for the wrapped style:
+
+   assert := assert.WrapT[int64](t)
+   assert(count).Equal(3)
+
+Negation, Navigation and Chaining
+
+Any wrapped assertion can be inverted with Not. Field, Index, Key, Deref and Call
+return assertions against a value reached from the wrapped one, failing instead of
+panicking if it can't be reached. Chain runs several assertions against one value,
+stopping at the first failure. Failures include the path navigated:
+
+   assert(id).Not().Regexp("^tmp_")
+   assert(resp).Field("Items").Index(0).Field("Name").Equal("John")
+   assert(resp).Chain().NotNil().Field("Status").Equal(200)
+
+Matchers
+
+A Matcher describes a check, and explains why a value failed it. Matchers can be
+combined with AllOf, AnyOf, Not and Each, and used with That or as the expected
+value of assertions like Equal and Contains:
+
+   assert.That(t, events, assert.Each(assert.AllOf(
+     assert.HasField("Status", "ok"),
+     assert.HasField("ID", assert.MatchesRegexp("^evt_")),
+   )))
+
+   assert.Equal(t, map[string]interface{}{
+     "id":   assert.MatchesRegexp("^evt_"),
+     "tags": assert.HasLen(2),
+   }, body)
+
+Error Fixtures
+
+As well as AnError, there are helpers for driving error paths: ErrorChain,
+WrapError and JoinErrors build errors with a known chain, TemporaryError and
+TimeoutError can be used as a net.Error, and FailingReader and FailingWriter
+return an error after a given number of bytes:
+
+   _, err := Decode(assert.FailingReader(strings.NewReader(input), 10, io.ErrUnexpectedEOF))
+   assert.ErrorIs(t, err, io.ErrUnexpectedEOF)
 */
 package assert
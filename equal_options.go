@@ -0,0 +1,126 @@
+package assert
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// EqualOption configures how EqualWith compares values.
+type EqualOption func(*equalOptions)
+
+// splitEqualOptions separates the EqualOption values in msgAndArgs from the
+// message and its arguments.
+func splitEqualOptions(msgAndArgs []interface{}) ([]EqualOption, []interface{}) {
+	var opts []EqualOption
+	var rest []interface{}
+	for _, arg := range msgAndArgs {
+		if opt, ok := arg.(EqualOption); ok {
+			opts = append(opts, opt)
+		} else {
+			rest = append(rest, arg)
+		}
+	}
+
+	return opts, rest
+}
+
+type equalOptions struct {
+	ignoredFields    map[string]bool
+	ignoreUnexported bool
+	comparers        map[reflect.Type]reflect.Value
+	useEqualMethod   bool
+	equateEmpty      bool
+}
+
+// IgnoreFields skips struct fields with any of the given names, at any depth,
+// when comparing.
+//
+//    assert.EqualWith(t, expected, actual, assert.IgnoreFields("CreatedAt", "ID"))
+func IgnoreFields(names ...string) EqualOption {
+	return func(o *equalOptions) {
+		if o.ignoredFields == nil {
+			o.ignoredFields = map[string]bool{}
+		}
+		for _, name := range names {
+			o.ignoredFields[name] = true
+		}
+	}
+}
+
+// IgnoreUnexported skips all unexported struct fields when comparing.
+func IgnoreUnexported() EqualOption {
+	return func(o *equalOptions) {
+		o.ignoreUnexported = true
+	}
+}
+
+// Comparer uses the function f, which must have the form func(T, T) bool, to
+// compare any values of type T.
+//
+//    assert.EqualWith(t, expected, actual, assert.Comparer(func(a, b float64) bool {
+//      return math.Abs(a-b) < 0.01
+//    }))
+func Comparer(f interface{}) EqualOption {
+	fv := reflect.ValueOf(f)
+	ft := fv.Type()
+	if ft.Kind() != reflect.Func || ft.NumIn() != 2 || ft.NumOut() != 1 ||
+		ft.In(0) != ft.In(1) || ft.Out(0).Kind() != reflect.Bool {
+		panic(fmt.Sprintf("assert: Comparer must be of the form func(T, T) bool, got %v", ft))
+	}
+
+	return func(o *equalOptions) {
+		if o.comparers == nil {
+			o.comparers = map[reflect.Type]reflect.Value{}
+		}
+		o.comparers[ft.In(0)] = fv
+	}
+}
+
+// UseEqualMethod compares values of any type T that has a method of the form
+// Equal(T) bool, such as time.Time, by calling that method.
+func UseEqualMethod() EqualOption {
+	return func(o *equalOptions) {
+		o.useEqualMethod = true
+	}
+}
+
+// EquateEmpty treats nil and empty slices, and nil and empty maps, as equal.
+func EquateEmpty() EqualOption {
+	return func(o *equalOptions) {
+		o.equateEmpty = true
+	}
+}
+
+func (o equalOptions) ignoreField(field reflect.StructField) bool {
+	return o.ignoredFields[field.Name] || (o.ignoreUnexported && field.PkgPath != "")
+}
+
+// compare uses a Comparer or Equal method to compare expected and actual,
+// returning ok as false when neither applies. Values in unexported fields are
+// never passed to user code.
+func (o equalOptions) compare(expected, actual reflect.Value) (equal, ok bool) {
+	if !expected.CanInterface() || !actual.CanInterface() {
+		return false, false
+	}
+
+	switch expected.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if expected.IsNil() || actual.IsNil() {
+			return false, false
+		}
+	}
+
+	if f, found := o.comparers[expected.Type()]; found {
+		return f.Call([]reflect.Value{expected, actual})[0].Bool(), true
+	}
+
+	if o.useEqualMethod {
+		method, found := expected.Type().MethodByName("Equal")
+		if found && method.Type.NumIn() == 2 && method.Type.In(1) == expected.Type() &&
+			method.Type.NumOut() == 1 && method.Type.Out(0).Kind() == reflect.Bool {
+			return expected.Method(method.Index).Call([]reflect.Value{actual})[0].Bool(), true
+		}
+	}
+
+	return false, false
+}
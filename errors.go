@@ -2,9 +2,194 @@ package assert
 
 import (
 	"errors"
+	"fmt"
+	"io"
 )
 
 // AnError is an error instance useful for testing.  If the code does not care
 // about error specifics, and only needs to return the error for example, this
 // error should be used to make the test code more readable.
 var AnError = errors.New("assert.AnError general error for testing")
+
+// chainError is an error that wraps the next error in a chain.
+type chainError struct {
+	err  error
+	next error
+}
+
+func (e *chainError) Error() string {
+	return e.err.Error() + ": " + e.next.Error()
+}
+
+func (e *chainError) Is(target error) bool {
+	return errors.Is(e.err, target)
+}
+
+func (e *chainError) As(target interface{}) bool {
+	return errors.As(e.err, target)
+}
+
+func (e *chainError) Unwrap() error {
+	return e.next
+}
+
+// ErrorChain returns an error where each of errs wraps the one after it, so that
+// errors.Is and errors.As will find any of them. Nil errors are skipped, and nil
+// is returned if there are no errors.
+//
+//    err := assert.ErrorChain(errNotFound, fs.ErrNotExist)
+//    errors.Is(err, fs.ErrNotExist) // true
+//    err.Error()                    // "not found: file does not exist"
+func ErrorChain(errs ...error) error {
+	var chain error
+	for i := len(errs) - 1; i >= 0; i-- {
+		if errs[i] == nil {
+			continue
+		}
+		if chain == nil {
+			chain = errs[i]
+		} else {
+			chain = &chainError{err: errs[i], next: chain}
+		}
+	}
+
+	return chain
+}
+
+// WrapError returns an error with the message "msg: err" that wraps err, in the
+// same way as fmt.Errorf with %w.
+//
+//    err := assert.WrapError(io.EOF, "reading header")
+func WrapError(err error, msg string) error {
+	return fmt.Errorf("%s: %w", msg, err)
+}
+
+// JoinErrors returns an error that wraps all of errs, in the same way as
+// errors.Join.
+//
+//    err := assert.JoinErrors(errMissingName, errMissingEmail)
+func JoinErrors(errs ...error) error {
+	return errors.Join(errs...)
+}
+
+// netError is an error with Timeout and Temporary methods, so it can be used as
+// a net.Error.
+type netError struct {
+	msg       string
+	timeout   bool
+	temporary bool
+}
+
+func (e *netError) Error() string   { return e.msg }
+func (e *netError) Timeout() bool   { return e.timeout }
+func (e *netError) Temporary() bool { return e.temporary }
+
+// TemporaryError returns an error with the message msg that reports true from
+// its Temporary method and false from its Timeout method.
+//
+//    conn.readErr = assert.TemporaryError("connection reset")
+func TemporaryError(msg string) error {
+	return &netError{msg: msg, temporary: true}
+}
+
+// TimeoutError returns an error with the message msg that reports true from both
+// its Timeout and Temporary methods, like the errors returned by the net
+// package when a deadline is exceeded.
+//
+//    conn.readErr = assert.TimeoutError("i/o timeout")
+func TimeoutError(msg string) error {
+	return &netError{msg: msg, timeout: true, temporary: true}
+}
+
+// failingReader is the io.Reader returned by FailingReader.
+type failingReader struct {
+	r   io.Reader
+	n   int
+	err error
+}
+
+func (f *failingReader) Read(p []byte) (int, error) {
+	if f.n <= 0 {
+		return 0, f.err
+	}
+	if len(p) > f.n {
+		p = p[:f.n]
+	}
+
+	n, err := f.r.Read(p)
+	f.n -= n
+	if err == nil && f.n <= 0 {
+		err = f.err
+	}
+
+	return n, err
+}
+
+// FailingReader returns an io.Reader that reads at most n bytes from r, then
+// returns err. If r is nil the bytes read are all zero, and if err is nil then
+// AnError is returned.
+//
+//    body := assert.FailingReader(strings.NewReader(`{"id": 1`), 5, io.ErrUnexpectedEOF)
+func FailingReader(r io.Reader, n int, err error) io.Reader {
+	if r == nil {
+		r = zeroReader{}
+	}
+	if err == nil {
+		err = AnError
+	}
+
+	return &failingReader{r: r, n: n, err: err}
+}
+
+type zeroReader struct{}
+
+func (zeroReader) Read(p []byte) (int, error) {
+	for i := range p {
+		p[i] = 0
+	}
+
+	return len(p), nil
+}
+
+// failingWriter is the io.Writer returned by FailingWriter.
+type failingWriter struct {
+	w   io.Writer
+	n   int
+	err error
+}
+
+func (f *failingWriter) Write(p []byte) (int, error) {
+	if len(p) <= f.n {
+		n, err := f.w.Write(p)
+		f.n -= n
+		return n, err
+	}
+
+	n, err := f.w.Write(p[:f.n])
+	f.n -= n
+	if err != nil {
+		return n, err
+	}
+
+	return n, f.err
+}
+
+// FailingWriter returns an io.Writer that writes at most n bytes to w, then
+// returns err. If w is nil the bytes are discarded, and if err is nil then
+// AnError is returned.
+//
+//    var buf bytes.Buffer
+//    err := render(assert.FailingWriter(&buf, 10, io.ErrShortWrite))
+func FailingWriter(w io.Writer, n int, err error) io.Writer {
+	if w == nil {
+		w = io.Discard
+	}
+	if n < 0 {
+		n = 0
+	}
+	if err == nil {
+		err = AnError
+	}
+
+	return &failingWriter{w: w, n: n, err: err}
+}
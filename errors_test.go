@@ -0,0 +1,88 @@
+package assert
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"io/fs"
+	"net"
+	"strings"
+	"testing"
+)
+
+func TestErrorChain(t *testing.T) {
+	errNotFound := errors.New("not found")
+	err := ErrorChain(errNotFound, WrapError(fs.ErrNotExist, "open config"))
+
+	Equal(t, "not found: open config: file does not exist", err.Error())
+	ErrorIs(t, err, errNotFound)
+	ErrorIs(t, err, fs.ErrNotExist)
+	NotErrorIs(t, err, io.EOF)
+
+	var target *netError
+	True(t, ErrorAs(t, ErrorChain(errNotFound, TimeoutError("slow")), &target))
+	Equal(t, "slow", target.Error())
+
+	Equal(t, errNotFound, ErrorChain(nil, errNotFound, nil))
+	Nil(t, ErrorChain())
+}
+
+func TestJoinErrors(t *testing.T) {
+	err := JoinErrors(io.EOF, AnError)
+
+	Equal(t, "EOF\n"+AnError.Error(), err.Error())
+	ErrorIs(t, err, io.EOF)
+	ErrorIs(t, err, AnError)
+}
+
+func TestTemporaryAndTimeoutErrors(t *testing.T) {
+	var netErr net.Error
+
+	if ErrorAs(t, TemporaryError("reset"), &netErr) {
+		True(t, netErr.Temporary())
+		False(t, netErr.Timeout())
+		Equal(t, "reset", netErr.Error())
+	}
+
+	if ErrorAs(t, TimeoutError("i/o timeout"), &netErr) {
+		True(t, netErr.Temporary())
+		True(t, netErr.Timeout())
+	}
+}
+
+func TestFailingReader(t *testing.T) {
+	r := FailingReader(strings.NewReader("hello world"), 5, io.ErrUnexpectedEOF)
+
+	data, err := io.ReadAll(r)
+	Equal(t, "hello", string(data))
+	Equal(t, io.ErrUnexpectedEOF, err)
+
+	data, err = io.ReadAll(FailingReader(strings.NewReader("hi"), 5, nil))
+	Equal(t, "hi", string(data))
+	Nil(t, err)
+
+	data, err = io.ReadAll(FailingReader(nil, 3, nil))
+	Equal(t, []byte{0, 0, 0}, data)
+	Equal(t, AnError, err)
+}
+
+func TestFailingWriter(t *testing.T) {
+	var buf bytes.Buffer
+	w := FailingWriter(&buf, 7, io.ErrShortWrite)
+
+	n, err := w.Write([]byte("hello "))
+	Equal(t, 6, n)
+	Nil(t, err)
+
+	n, err = w.Write([]byte("world"))
+	Equal(t, 1, n)
+	Equal(t, io.ErrShortWrite, err)
+	Equal(t, "hello w", buf.String())
+
+	n, err = w.Write([]byte("!"))
+	Equal(t, 0, n)
+	Equal(t, io.ErrShortWrite, err)
+
+	_, err = FailingWriter(nil, 0, nil).Write([]byte("a"))
+	Equal(t, AnError, err)
+}
@@ -15,8 +15,25 @@ func New(t TestingT) *Assertions {
 	}
 }
 
+// WithContext returns Assertions that report to the same TestingT, but include
+// the label given by format and args in the messages of their failures. Labels
+// are nested when WithContext is called again on the result.
+//
+//    for i, tc := range cases {
+//      assert := assert.WithContext("case %d: %s", i, tc.name)
+//      assert.Equal(tc.expected, Parse(tc.input))
+//    }
+func (a *Assertions) WithContext(format string, args ...interface{}) *Assertions {
+	return &Assertions{
+		t: WithContext(a.t, format, args...),
+	}
+}
+
 // Fail reports a failure through
 func (a *Assertions) Fail(failureMessage string, msgAndArgs ...interface{}) bool {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
 	return Fail(a.t, failureMessage, msgAndArgs...)
 }
 
@@ -24,11 +41,17 @@ func (a *Assertions) Fail(failureMessage string, msgAndArgs ...interface{}) bool
 //
 //    assert.Implements((*MyInterface)(nil), new(MyObject), "MyObject")
 func (a *Assertions) Implements(interfaceObject interface{}, object interface{}, msgAndArgs ...interface{}) bool {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
 	return Implements(a.t, interfaceObject, object, msgAndArgs...)
 }
 
 // IsType asserts that the specified objects are of the same type.
 func (a *Assertions) IsType(expectedType interface{}, object interface{}, msgAndArgs ...interface{}) bool {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
 	return IsType(a.t, expectedType, object, msgAndArgs...)
 }
 
@@ -38,6 +61,9 @@ func (a *Assertions) IsType(expectedType interface{}, object interface{}, msgAnd
 //
 // Returns whether the assertion was successful (true) or not (false).
 func (a *Assertions) Equal(expected, actual interface{}, msgAndArgs ...interface{}) bool {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
 	return Equal(a.t, expected, actual, msgAndArgs...)
 }
 
@@ -48,6 +74,9 @@ func (a *Assertions) Equal(expected, actual interface{}, msgAndArgs ...interface
 //
 // Returns whether the assertion was successful (true) or not (false).
 func (a *Assertions) Equivalent(expected, actual interface{}, msgAndArgs ...interface{}) bool {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
 	return Equivalent(a.t, expected, actual, msgAndArgs...)
 }
 
@@ -57,15 +86,35 @@ func (a *Assertions) Equivalent(expected, actual interface{}, msgAndArgs ...inte
 //
 // Returns whether the assertion was successful (true) or not (false).
 func (a *Assertions) Exactly(expected, actual interface{}, msgAndArgs ...interface{}) bool {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
 	return Exactly(a.t, expected, actual, msgAndArgs...)
 }
 
+// EqualWith asserts that two objects are equal, using the EqualOption values in
+// msgAndArgs to control how they are compared. The other values in msgAndArgs
+// are used as the message, as for any other assertion.
+//
+//    assert.EqualWith(expected, actual, assert.IgnoreFields("CreatedAt"))
+//
+// Returns whether the assertion was successful (true) or not (false).
+func (a *Assertions) EqualWith(expected, actual interface{}, msgAndArgs ...interface{}) bool {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	return EqualWith(a.t, expected, actual, msgAndArgs...)
+}
+
 // NotNil asserts that the specified object is not nil.
 //
 //    assert.NotNil(err, "err should be something")
 //
 // Returns whether the assertion was successful (true) or not (false).
 func (a *Assertions) NotNil(object interface{}, msgAndArgs ...interface{}) bool {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
 	return NotNil(a.t, object, msgAndArgs...)
 }
 
@@ -75,9 +124,108 @@ func (a *Assertions) NotNil(object interface{}, msgAndArgs ...interface{}) bool
 //
 // Returns whether the assertion was successful (true) or not (false).
 func (a *Assertions) Nil(object interface{}, msgAndArgs ...interface{}) bool {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
 	return Nil(a.t, object, msgAndArgs...)
 }
 
+// Error asserts that a function returned an error (i.e. not nil).
+//
+//    actualObj, err := SomeFunction()
+//    assert.Error(err, "An error was expected")
+//
+// Returns whether the assertion was successful (true) or not (false).
+func (a *Assertions) Error(err error, msgAndArgs ...interface{}) bool {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	return Error(a.t, err, msgAndArgs...)
+}
+
+// NoError asserts that a function returned no error (i.e. nil).
+//
+//    actualObj, err := SomeFunction()
+//    if assert.NoError(err) {
+//      assert.Equal(expectedObj, actualObj)
+//    }
+//
+// Returns whether the assertion was successful (true) or not (false).
+func (a *Assertions) NoError(err error, msgAndArgs ...interface{}) bool {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	return NoError(a.t, err, msgAndArgs...)
+}
+
+// ErrorIs asserts that at least one of the errors in err's chain matches
+// target, using errors.Is.
+//
+//    assert.ErrorIs(err, fs.ErrNotExist)
+//
+// Returns whether the assertion was successful (true) or not (false).
+func (a *Assertions) ErrorIs(err, target error, msgAndArgs ...interface{}) bool {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	return ErrorIs(a.t, err, target, msgAndArgs...)
+}
+
+// NotErrorIs asserts that none of the errors in err's chain match target,
+// using errors.Is.
+//
+//    assert.NotErrorIs(err, fs.ErrNotExist)
+//
+// Returns whether the assertion was successful (true) or not (false).
+func (a *Assertions) NotErrorIs(err, target error, msgAndArgs ...interface{}) bool {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	return NotErrorIs(a.t, err, target, msgAndArgs...)
+}
+
+// ErrorAs asserts that at least one of the errors in err's chain matches
+// target, using errors.As, and if so sets target to that error.
+//
+//    var pathErr *fs.PathError
+//    assert.ErrorAs(err, &pathErr)
+//
+// Returns whether the assertion was successful (true) or not (false).
+func (a *Assertions) ErrorAs(err error, target interface{}, msgAndArgs ...interface{}) bool {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	return ErrorAs(a.t, err, target, msgAndArgs...)
+}
+
+// EqualError asserts that a function returned an error (i.e. not nil) and that
+// it is equal to the provided error message.
+//
+//    actualObj, err := SomeFunction()
+//    assert.EqualError(err, expectedErrorString, "An error was expected")
+//
+// Returns whether the assertion was successful (true) or not (false).
+func (a *Assertions) EqualError(theError error, errString string, msgAndArgs ...interface{}) bool {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	return EqualError(a.t, theError, errString, msgAndArgs...)
+}
+
+// ErrorContains asserts that a function returned an error (i.e. not nil) and
+// that its message contains the specified substring.
+//
+//    actualObj, err := SomeFunction()
+//    assert.ErrorContains(err, "not found")
+//
+// Returns whether the assertion was successful (true) or not (false).
+func (a *Assertions) ErrorContains(theError error, contains string, msgAndArgs ...interface{}) bool {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	return ErrorContains(a.t, theError, contains, msgAndArgs...)
+}
+
 // Empty asserts that the specified object is empty.  I.e. nil, "", false, 0 or a
 // slice with len == 0.
 //
@@ -85,6 +233,9 @@ func (a *Assertions) Nil(object interface{}, msgAndArgs ...interface{}) bool {
 //
 // Returns whether the assertion was successful (true) or not (false).
 func (a *Assertions) Empty(object interface{}, msgAndArgs ...interface{}) bool {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
 	return Empty(a.t, object, msgAndArgs...)
 }
 
@@ -97,6 +248,9 @@ func (a *Assertions) Empty(object interface{}, msgAndArgs ...interface{}) bool {
 //
 // Returns whether the assertion was successful (true) or not (false).
 func (a *Assertions) NotEmpty(object interface{}, msgAndArgs ...interface{}) bool {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
 	return NotEmpty(a.t, object, msgAndArgs...)
 }
 
@@ -107,6 +261,9 @@ func (a *Assertions) NotEmpty(object interface{}, msgAndArgs ...interface{}) boo
 //
 // Returns whether the assertion was successful (true) or not (false).
 func (a *Assertions) Len(object interface{}, length int, msgAndArgs ...interface{}) bool {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
 	return Len(a.t, object, length, msgAndArgs...)
 }
 
@@ -116,6 +273,9 @@ func (a *Assertions) Len(object interface{}, length int, msgAndArgs ...interface
 //
 // Returns whether the assertion was successful (true) or not (false).
 func (a *Assertions) True(value bool, msgAndArgs ...interface{}) bool {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
 	return True(a.t, value, msgAndArgs...)
 }
 
@@ -125,6 +285,9 @@ func (a *Assertions) True(value bool, msgAndArgs ...interface{}) bool {
 //
 // Returns whether the assertion was successful (true) or not (false).
 func (a *Assertions) False(value bool, msgAndArgs ...interface{}) bool {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
 	return False(a.t, value, msgAndArgs...)
 }
 
@@ -134,6 +297,9 @@ func (a *Assertions) False(value bool, msgAndArgs ...interface{}) bool {
 //
 // Returns whether the assertion was successful (true) or not (false).
 func (a *Assertions) NotEqual(expected, actual interface{}, msgAndArgs ...interface{}) bool {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
 	return NotEqual(a.t, expected, actual, msgAndArgs...)
 }
 
@@ -143,6 +309,9 @@ func (a *Assertions) NotEqual(expected, actual interface{}, msgAndArgs ...interf
 //
 // Returns whether the assertion was successful (true) or not (false).
 func (a *Assertions) Contains(s, contains interface{}, msgAndArgs ...interface{}) bool {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
 	return Contains(a.t, s, contains, msgAndArgs...)
 }
 
@@ -152,14 +321,171 @@ func (a *Assertions) Contains(s, contains interface{}, msgAndArgs ...interface{}
 //
 // Returns whether the assertion was successful (true) or not (false).
 func (a *Assertions) NotContains(s, contains interface{}, msgAndArgs ...interface{}) bool {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
 	return NotContains(a.t, s, contains, msgAndArgs...)
 }
 
+// ElementsMatch asserts that the specified lists (arrays or slices) contain the
+// same elements, ignoring their order. Duplicates must appear the same number of
+// times in each list.
+//
+//    assert.ElementsMatch([]int{1, 3, 2, 3}, []int{3, 3, 1, 2})
+//
+// Returns whether the assertion was successful (true) or not (false).
+func (a *Assertions) ElementsMatch(expected, actual interface{}, msgAndArgs ...interface{}) bool {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	return ElementsMatch(a.t, expected, actual, msgAndArgs...)
+}
+
+// Subset asserts that the specified list (array or slice) contains all of the
+// elements of subset, or that the specified map contains all of the keys of
+// subset with the same values.
+//
+//    assert.Subset([]int{1, 2, 3}, []int{1, 3})
+//    assert.Subset(map[string]int{"a": 1, "b": 2}, map[string]int{"a": 1})
+//
+// Returns whether the assertion was successful (true) or not (false).
+func (a *Assertions) Subset(list, subset interface{}, msgAndArgs ...interface{}) bool {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	return Subset(a.t, list, subset, msgAndArgs...)
+}
+
+// NotSubset asserts that the specified list (array or slice) does NOT contain
+// all of the elements of subset, or that the specified map does NOT contain all
+// of the entries of subset.
+//
+//    assert.NotSubset([]int{1, 2, 3}, []int{1, 4})
+//    assert.NotSubset(map[string]int{"a": 1, "b": 2}, map[string]int{"a": 2})
+//
+// Returns whether the assertion was successful (true) or not (false).
+func (a *Assertions) NotSubset(list, subset interface{}, msgAndArgs ...interface{}) bool {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	return NotSubset(a.t, list, subset, msgAndArgs...)
+}
+
+// HasKey asserts that the specified map contains the key.
+//
+//    assert.HasKey(map[string]int{"a": 1}, "a")
+//
+// Returns whether the assertion was successful (true) or not (false).
+func (a *Assertions) HasKey(m, key interface{}, msgAndArgs ...interface{}) bool {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	return HasKey(a.t, m, key, msgAndArgs...)
+}
+
+// NotHasKey asserts that the specified map does NOT contain the key.
+//
+//    assert.NotHasKey(map[string]int{"a": 1}, "b")
+//
+// Returns whether the assertion was successful (true) or not (false).
+func (a *Assertions) NotHasKey(m, key interface{}, msgAndArgs ...interface{}) bool {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	return NotHasKey(a.t, m, key, msgAndArgs...)
+}
+
+// HasEntry asserts that the specified map contains the key, and that its value
+// is equal to the expected value.
+//
+//    assert.HasEntry(map[string]int{"a": 1}, "a", 1)
+//
+// Returns whether the assertion was successful (true) or not (false).
+func (a *Assertions) HasEntry(m, key, expected interface{}, msgAndArgs ...interface{}) bool {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	return HasEntry(a.t, m, key, expected, msgAndArgs...)
+}
+
 // Condition uses a Comparison to assert a complex condition.
 func (a *Assertions) Condition(comp Comparison, msgAndArgs ...interface{}) bool {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
 	return Condition(a.t, comp, msgAndArgs...)
 }
 
+// That asserts that actual matches the Matcher.
+//
+//    assert.That(events, assert.Each(assert.AllOf(
+//      assert.HasField("Status", "ok"),
+//      assert.HasField("ID", assert.MatchesRegexp("^evt_")),
+//    )))
+//
+// Returns whether the assertion was successful (true) or not (false).
+func (a *Assertions) That(actual interface{}, matcher Matcher, msgAndArgs ...interface{}) bool {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	return That(a.t, actual, matcher, msgAndArgs...)
+}
+
+// Eventually asserts that the Comparison returns true within waitFor, calling
+// it every tick.
+//
+//    assert.Eventually(func() bool { return cache.Len() == 3 }, time.Second, 10*time.Millisecond)
+//
+// Returns whether the assertion was successful (true) or not (false).
+func (a *Assertions) Eventually(comp Comparison, waitFor, tick time.Duration, msgAndArgs ...interface{}) bool {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	return Eventually(a.t, comp, waitFor, tick, msgAndArgs...)
+}
+
+// Never asserts that the Comparison does not return true within waitFor,
+// calling it every tick.
+//
+//    assert.Never(func() bool { return worker.Crashed() }, time.Second, 10*time.Millisecond)
+//
+// Returns whether the assertion was successful (true) or not (false).
+func (a *Assertions) Never(comp Comparison, waitFor, tick time.Duration, msgAndArgs ...interface{}) bool {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	return Never(a.t, comp, waitFor, tick, msgAndArgs...)
+}
+
+// Consistently asserts that the Comparison returns true every time it is
+// called, every tick, for the whole of waitFor.
+//
+//    assert.Consistently(func() bool { return conn.Open() }, time.Second, 10*time.Millisecond)
+//
+// Returns whether the assertion was successful (true) or not (false).
+func (a *Assertions) Consistently(comp Comparison, waitFor, tick time.Duration, msgAndArgs ...interface{}) bool {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	return Consistently(a.t, comp, waitFor, tick, msgAndArgs...)
+}
+
+// EventuallyWithT asserts that all of the assertions made in condition pass
+// within waitFor, calling it every tick. Failures from attempts other than the
+// last are discarded.
+//
+//    assert.EventuallyWithT(func(c *assert.CollectT) {
+//      assert.Equal(c, 3, cache.Len())
+//    }, time.Second, 10*time.Millisecond)
+//
+// Returns whether the assertion was successful (true) or not (false).
+func (a *Assertions) EventuallyWithT(condition func(c *CollectT), waitFor, tick time.Duration, msgAndArgs ...interface{}) bool {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	return EventuallyWithT(a.t, condition, waitFor, tick, msgAndArgs...)
+}
+
 // Panics asserts that the code inside the specified func panics.
 //
 //   assert.Panics(func(){
@@ -168,9 +494,62 @@ func (a *Assertions) Condition(comp Comparison, msgAndArgs ...interface{}) bool
 //
 // Returns whether the assertion was successful (true) or not (false).
 func (a *Assertions) Panics(f func(), msgAndArgs ...interface{}) bool {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
 	return Panics(a.t, f, msgAndArgs...)
 }
 
+// PanicsWithValue asserts that the code inside the specified func panics, and
+// that the recovered panic value equals the expected value.
+//
+//   assert.PanicsWithValue("crazy error", func(){
+//     GoCrazy()
+//   }, "Calling GoCrazy() should panic with 'crazy error'")
+//
+// Returns whether the assertion was successful (true) or not (false).
+func (a *Assertions) PanicsWithValue(expected interface{}, f func(), msgAndArgs ...interface{}) bool {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	return PanicsWithValue(a.t, expected, f, msgAndArgs...)
+}
+
+// PanicsWithError asserts that the code inside the specified func panics with an
+// error. If expected is an error the recovered error must match it using
+// errors.Is, otherwise the message of the recovered error must equal expected.
+//
+//   assert.PanicsWithError(ErrCrazy, func(){
+//     GoCrazy()
+//   })
+//   assert.PanicsWithError("crazy error", func(){
+//     GoCrazy()
+//   })
+//
+// Returns whether the assertion was successful (true) or not (false).
+func (a *Assertions) PanicsWithError(expected interface{}, f func(), msgAndArgs ...interface{}) bool {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	return PanicsWithError(a.t, expected, f, msgAndArgs...)
+}
+
+// PanicsMatching asserts that the code inside the specified func panics, and
+// that the recovered panic value matches the regexp. Errors are matched using
+// their message, and all other values are formatted with %v.
+//
+//   assert.PanicsMatching("index out of range", func(){
+//     GoCrazy()
+//   })
+//
+// Returns whether the assertion was successful (true) or not (false).
+func (a *Assertions) PanicsMatching(rx interface{}, f func(), msgAndArgs ...interface{}) bool {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	return PanicsMatching(a.t, rx, f, msgAndArgs...)
+}
+
 // NotPanics asserts that the code inside the specified func does NOT panic.
 //
 //   assert.NotPanics(func(){
@@ -179,6 +558,9 @@ func (a *Assertions) Panics(f func(), msgAndArgs ...interface{}) bool {
 //
 // Returns whether the assertion was successful (true) or not (false).
 func (a *Assertions) NotPanics(f func(), msgAndArgs ...interface{}) bool {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
 	return NotPanics(a.t, f, msgAndArgs...)
 }
 
@@ -188,6 +570,9 @@ func (a *Assertions) NotPanics(f func(), msgAndArgs ...interface{}) bool {
 //
 // Returns whether the assertion was successful (true) or not (false).
 func (a *Assertions) WithinDuration(expected, actual time.Time, delta time.Duration, msgAndArgs ...interface{}) bool {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
 	return WithinDuration(a.t, expected, actual, delta, msgAndArgs...)
 }
 
@@ -197,6 +582,9 @@ func (a *Assertions) WithinDuration(expected, actual time.Time, delta time.Durat
 //
 // Returns whether the assertion was successful (true) or not (false).
 func (a *Assertions) InDelta(expected, actual interface{}, delta float64, msgAndArgs ...interface{}) bool {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
 	return InDelta(a.t, expected, actual, delta, msgAndArgs...)
 }
 
@@ -204,9 +592,191 @@ func (a *Assertions) InDelta(expected, actual interface{}, delta float64, msgAnd
 //
 // Returns whether the assertion was successful (true) or not (false).
 func (a *Assertions) InEpsilon(expected, actual interface{}, epsilon float64, msgAndArgs ...interface{}) bool {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
 	return InEpsilon(a.t, expected, actual, epsilon, msgAndArgs...)
 }
 
+// Greater asserts that the first element is greater than the second. Both must
+// be the same type, which can be any number, string, time.Time or big.Int.
+//
+//    assert.Greater(2, 1)
+//    assert.Greater("b", "a")
+//    assert.Greater(time.Now(), start)
+//
+// Returns whether the assertion was successful (true) or not (false).
+func (a *Assertions) Greater(e1, e2 interface{}, msgAndArgs ...interface{}) bool {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	return Greater(a.t, e1, e2, msgAndArgs...)
+}
+
+// GreaterOrEqual asserts that the first element is greater than or equal to the
+// second.
+//
+//    assert.GreaterOrEqual(2, 1)
+//    assert.GreaterOrEqual(2, 2)
+//
+// Returns whether the assertion was successful (true) or not (false).
+func (a *Assertions) GreaterOrEqual(e1, e2 interface{}, msgAndArgs ...interface{}) bool {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	return GreaterOrEqual(a.t, e1, e2, msgAndArgs...)
+}
+
+// Less asserts that the first element is less than the second.
+//
+//    assert.Less(1, 2)
+//    assert.Less(time.Second, timeout)
+//
+// Returns whether the assertion was successful (true) or not (false).
+func (a *Assertions) Less(e1, e2 interface{}, msgAndArgs ...interface{}) bool {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	return Less(a.t, e1, e2, msgAndArgs...)
+}
+
+// LessOrEqual asserts that the first element is less than or equal to the
+// second.
+//
+//    assert.LessOrEqual(1, 2)
+//    assert.LessOrEqual(2, 2)
+//
+// Returns whether the assertion was successful (true) or not (false).
+func (a *Assertions) LessOrEqual(e1, e2 interface{}, msgAndArgs ...interface{}) bool {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	return LessOrEqual(a.t, e1, e2, msgAndArgs...)
+}
+
+// Positive asserts that the specified number, time.Duration or big.Int is
+// greater than zero.
+//
+//    assert.Positive(1)
+//    assert.Positive(elapsed)
+//
+// Returns whether the assertion was successful (true) or not (false).
+func (a *Assertions) Positive(e interface{}, msgAndArgs ...interface{}) bool {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	return Positive(a.t, e, msgAndArgs...)
+}
+
+// Negative asserts that the specified number, time.Duration or big.Int is less
+// than zero.
+//
+//    assert.Negative(-1)
+//
+// Returns whether the assertion was successful (true) or not (false).
+func (a *Assertions) Negative(e interface{}, msgAndArgs ...interface{}) bool {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	return Negative(a.t, e, msgAndArgs...)
+}
+
+// Zero asserts that the specified value is zero. Numbers, time.Duration and
+// big.Int are zero when they equal 0, values with an IsZero() bool method, such
+// as time.Time, when it returns true, and any other value when it is the zero
+// value of its type, such as "" or nil.
+//
+//    assert.Zero(balance.Sub(credits))
+//
+// Returns whether the assertion was successful (true) or not (false).
+func (a *Assertions) Zero(e interface{}, msgAndArgs ...interface{}) bool {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	return Zero(a.t, e, msgAndArgs...)
+}
+
+// NotZero asserts that the specified value is not zero, as described for Zero.
+//
+//    assert.NotZero(elapsed)
+//
+// Returns whether the assertion was successful (true) or not (false).
+func (a *Assertions) NotZero(e interface{}, msgAndArgs ...interface{}) bool {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	return NotZero(a.t, e, msgAndArgs...)
+}
+
+// IsSorted asserts that the specified list is sorted in ascending order, allowing
+// equal elements. The list can be an array or slice of any type accepted by
+// Greater, or a sort.Interface.
+//
+//    assert.IsSorted([]string{"a", "b", "b"})
+//    assert.IsSorted(sort.IntSlice(ids))
+//
+// Returns whether the assertion was successful (true) or not (false).
+func (a *Assertions) IsSorted(list interface{}, msgAndArgs ...interface{}) bool {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	return IsSorted(a.t, list, msgAndArgs...)
+}
+
+// IsSortedBy asserts that the specified list is sorted according to less, which
+// must be of the form func(a, b T) bool where T is the type of the elements.
+//
+//    assert.IsSortedBy(events, func(a, b Event) bool {
+//      return a.At.Before(b.At)
+//    })
+//
+// Returns whether the assertion was successful (true) or not (false).
+func (a *Assertions) IsSortedBy(list, less interface{}, msgAndArgs ...interface{}) bool {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	return IsSortedBy(a.t, list, less, msgAndArgs...)
+}
+
+// IsIncreasing asserts that each element of the specified list is greater than
+// the one before it.
+//
+//    assert.IsIncreasing([]int{1, 2, 3})
+//
+// Returns whether the assertion was successful (true) or not (false).
+func (a *Assertions) IsIncreasing(list interface{}, msgAndArgs ...interface{}) bool {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	return IsIncreasing(a.t, list, msgAndArgs...)
+}
+
+// IsNonDecreasing asserts that each element of the specified list is greater
+// than or equal to the one before it.
+//
+//    assert.IsNonDecreasing([]int{1, 1, 2})
+//
+// Returns whether the assertion was successful (true) or not (false).
+func (a *Assertions) IsNonDecreasing(list interface{}, msgAndArgs ...interface{}) bool {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	return IsNonDecreasing(a.t, list, msgAndArgs...)
+}
+
+// IsDecreasing asserts that each element of the specified list is less than the
+// one before it.
+//
+//    assert.IsDecreasing([]int{3, 2, 1})
+//
+// Returns whether the assertion was successful (true) or not (false).
+func (a *Assertions) IsDecreasing(list interface{}, msgAndArgs ...interface{}) bool {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	return IsDecreasing(a.t, list, msgAndArgs...)
+}
+
 // Regexp asserts that a specified regexp matches a string.
 //
 //  assert.Regexp(t, regexp.MustCompile("start"), "it's starting")
@@ -214,6 +784,9 @@ func (a *Assertions) InEpsilon(expected, actual interface{}, epsilon float64, ms
 //
 // Returns whether the assertion was successful (true) or not (false).
 func (a *Assertions) Regexp(rx interface{}, str interface{}, msgAndArgs ...interface{}) bool {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
 	return Regexp(a.t, rx, str, msgAndArgs...)
 }
 
@@ -224,5 +797,8 @@ func (a *Assertions) Regexp(rx interface{}, str interface{}, msgAndArgs ...inter
 //
 // Returns whether the assertion was successful (true) or not (false).
 func (a *Assertions) NotRegexp(rx interface{}, str interface{}, msgAndArgs ...interface{}) bool {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
 	return NotRegexp(a.t, rx, str, msgAndArgs...)
 }
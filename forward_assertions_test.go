@@ -2,6 +2,9 @@ package assert
 
 import (
 	"errors"
+	"fmt"
+	"io"
+	"io/fs"
 	"regexp"
 	"testing"
 	"time"
@@ -50,6 +53,17 @@ func TestEqualWrapper(t *testing.T) {
 	}
 }
 
+func TestEqualWithWrapper(t *testing.T) {
+	assert := New(new(testing.T))
+
+	if !assert.EqualWith([]int{}, []int(nil), EquateEmpty()) {
+		t.Error("EqualWith should return true")
+	}
+	if assert.EqualWith([]int{}, []int(nil)) {
+		t.Error("EqualWith should return false")
+	}
+}
+
 func TestEquivalentWrapper(t *testing.T) {
 	assert := New(new(testing.T))
 
@@ -196,6 +210,43 @@ func TestNotContainsWrapper(t *testing.T) {
 
 }
 
+func TestElementsMatchWrapper(t *testing.T) {
+	assert := New(new(testing.T))
+
+	if !assert.ElementsMatch([]int{1, 2, 2}, []int{2, 1, 2}) {
+		t.Error("ElementsMatch should return true")
+	}
+	if assert.ElementsMatch([]int{1, 2, 2}, []int{1, 1, 2}) {
+		t.Error("ElementsMatch should return false")
+	}
+}
+
+func TestSubsetWrapper(t *testing.T) {
+	assert := New(new(testing.T))
+
+	if !assert.Subset([]int{1, 2}, []int{2}) || assert.Subset([]int{1, 2}, []int{3}) {
+		t.Error("Subset should only return true for a subset")
+	}
+	if !assert.NotSubset([]int{1, 2}, []int{3}) || assert.NotSubset([]int{1, 2}, []int{2}) {
+		t.Error("NotSubset should only return true when not a subset")
+	}
+}
+
+func TestHasKeyWrapper(t *testing.T) {
+	assert := New(new(testing.T))
+	m := map[string]int{"a": 1}
+
+	if !assert.HasKey(m, "a") || assert.HasKey(m, "b") {
+		t.Error("HasKey should only return true for a key in the map")
+	}
+	if !assert.NotHasKey(m, "b") || assert.NotHasKey(m, "a") {
+		t.Error("NotHasKey should only return true for a key not in the map")
+	}
+	if !assert.HasEntry(m, "a", 1) || assert.HasEntry(m, "a", 2) {
+		t.Error("HasEntry should only return true for an entry in the map")
+	}
+}
+
 func TestConditionWrapper(t *testing.T) {
 
 	assert := New(new(testing.T))
@@ -212,13 +263,13 @@ func TestConditionWrapper(t *testing.T) {
 
 func TestDidPanicWrapper(t *testing.T) {
 
-	if funcDidPanic, _ := didPanic(func() {
+	if funcDidPanic, _, _ := didPanic(func() {
 		panic("Panic!")
 	}); !funcDidPanic {
 		t.Error("didPanic should return true")
 	}
 
-	if funcDidPanic, _ := didPanic(func() {
+	if funcDidPanic, _, _ := didPanic(func() {
 	}); funcDidPanic {
 		t.Error("didPanic should return false")
 	}
@@ -426,6 +477,66 @@ func TestInEpsilonWrapper(t *testing.T) {
 	}
 }
 
+func TestOrderingWrapper(t *testing.T) {
+	assert := New(new(testing.T))
+
+	if !assert.Greater(2, 1) || assert.Greater(1, 2) {
+		t.Error("Greater should only return true when e1 > e2")
+	}
+	if !assert.GreaterOrEqual(2, 2) || assert.GreaterOrEqual(1, 2) {
+		t.Error("GreaterOrEqual should only return true when e1 >= e2")
+	}
+	if !assert.Less(1, 2) || assert.Less(2, 1) {
+		t.Error("Less should only return true when e1 < e2")
+	}
+	if !assert.LessOrEqual(2, 2) || assert.LessOrEqual(2, 1) {
+		t.Error("LessOrEqual should only return true when e1 <= e2")
+	}
+	if !assert.Positive(1) || assert.Positive(0) {
+		t.Error("Positive should only return true when e > 0")
+	}
+	if !assert.Negative(-1) || assert.Negative(0) {
+		t.Error("Negative should only return true when e < 0")
+	}
+	if !assert.Zero(0) || assert.Zero(1) {
+		t.Error("Zero should only return true when e == 0")
+	}
+	if !assert.NotZero(1) || assert.NotZero(0) {
+		t.Error("NotZero should only return true when e != 0")
+	}
+}
+
+func TestSequenceOrderingWrapper(t *testing.T) {
+	assert := New(new(testing.T))
+
+	if !assert.IsSorted([]int{1, 1, 2}) || assert.IsSorted([]int{2, 1}) {
+		t.Error("IsSorted should only return true for a sorted list")
+	}
+	if !assert.IsSortedBy([]int{2, 1}, func(a, b int) bool { return a > b }) || assert.IsSortedBy([]int{1, 2}, func(a, b int) bool { return a > b }) {
+		t.Error("IsSortedBy should only return true for a list sorted by less")
+	}
+	if !assert.IsIncreasing([]int{1, 2}) || assert.IsIncreasing([]int{1, 1}) {
+		t.Error("IsIncreasing should only return true for an increasing list")
+	}
+	if !assert.IsNonDecreasing([]int{1, 1}) || assert.IsNonDecreasing([]int{2, 1}) {
+		t.Error("IsNonDecreasing should only return true for a non-decreasing list")
+	}
+	if !assert.IsDecreasing([]int{2, 1}) || assert.IsDecreasing([]int{1, 1}) {
+		t.Error("IsDecreasing should only return true for a decreasing list")
+	}
+}
+
+func TestThatWrapper(t *testing.T) {
+	assert := New(new(testing.T))
+
+	if !assert.That([]int{1, 2}, HasLen(2)) {
+		t.Error("That should return true")
+	}
+	if assert.That([]int{1, 2}, Each(1)) {
+		t.Error("That should return false")
+	}
+}
+
 func TestRegexpWrapper(t *testing.T) {
 
 	assert := New(new(testing.T))
@@ -460,3 +571,104 @@ func TestRegexpWrapper(t *testing.T) {
 		True(t, assert.NotRegexp(regexp.MustCompile(tc.rx), tc.str))
 	}
 }
+
+func TestPollingWrapper(t *testing.T) {
+	assert := New(new(testing.T))
+
+	if !assert.Eventually(func() bool { return true }, time.Second, time.Millisecond) {
+		t.Error("Eventually should return true")
+	}
+	if assert.Never(func() bool { return true }, time.Second, time.Millisecond) {
+		t.Error("Never should return false")
+	}
+	if !assert.Consistently(func() bool { return true }, 5*time.Millisecond, time.Millisecond) {
+		t.Error("Consistently should return true")
+	}
+	if assert.EventuallyWithT(func(c *CollectT) { c.Errorf("failed") }, 5*time.Millisecond, time.Millisecond) {
+		t.Error("EventuallyWithT should return false")
+	}
+}
+
+func TestErrorWrapper(t *testing.T) {
+	assert := New(new(testing.T))
+	wrapped := fmt.Errorf("wrapped: %w", io.EOF)
+
+	if !assert.Error(wrapped) || assert.Error(nil) {
+		t.Error("Error should only return true for non-nil errors")
+	}
+	if !assert.NoError(nil) || assert.NoError(wrapped) {
+		t.Error("NoError should only return true for nil errors")
+	}
+	if !assert.ErrorIs(wrapped, io.EOF) || assert.ErrorIs(wrapped, io.ErrUnexpectedEOF) {
+		t.Error("ErrorIs should only return true when target is in the chain")
+	}
+	if !assert.NotErrorIs(wrapped, io.ErrUnexpectedEOF) || assert.NotErrorIs(wrapped, io.EOF) {
+		t.Error("NotErrorIs should only return true when target is not in the chain")
+	}
+	var pathErr *fs.PathError
+	if assert.ErrorAs(wrapped, &pathErr) {
+		t.Error("ErrorAs should return false")
+	}
+	if !assert.EqualError(wrapped, "wrapped: EOF") || assert.EqualError(wrapped, "EOF") {
+		t.Error("EqualError should only return true for equal messages")
+	}
+	if !assert.ErrorContains(wrapped, "EOF") || assert.ErrorContains(wrapped, "nope") {
+		t.Error("ErrorContains should only return true when the message contains the string")
+	}
+}
+
+func TestPanicsWithValueWrapper(t *testing.T) {
+	assert := New(new(testing.T))
+
+	if !assert.PanicsWithValue("Panic!", func() { panic("Panic!") }) {
+		t.Error("PanicsWithValue should return true")
+	}
+	if assert.PanicsWithValue("Panic!", func() {}) {
+		t.Error("PanicsWithValue should return false")
+	}
+	if !assert.PanicsWithError(io.EOF, func() { panic(io.EOF) }) {
+		t.Error("PanicsWithError should return true")
+	}
+	if assert.PanicsWithError("EOF", func() { panic("EOF") }) {
+		t.Error("PanicsWithError should return false")
+	}
+	if !assert.PanicsMatching("^Pan", func() { panic("Panic!") }) {
+		t.Error("PanicsMatching should return true")
+	}
+	if assert.PanicsMatching("^Pan", func() { panic("Other!") }) {
+		t.Error("PanicsMatching should return false")
+	}
+}
+
+func TestWithContextWrapper(t *testing.T) {
+	bufT := new(helperT)
+	assert := New(bufT)
+
+	caseAssert := assert.WithContext("case %d", 1)
+	caseAssert.Equal(1, 2)
+	Contains(t, bufT.buf.String(), "Messages:\tcase 1\n")
+
+	bufT.buf.Reset()
+	caseAssert.WithContext("step %s", "login").Equal(1, 2, "should be %d", 2)
+	Contains(t, bufT.buf.String(), "Messages:\tcase 1: step login: should be 2\n")
+
+	bufT.buf.Reset()
+	assert.Equal(1, 2)
+	NotContains(t, bufT.buf.String(), "Messages:")
+
+	legacyT := new(bufferT)
+	New(legacyT).WithContext("case %d", 2).True(false)
+	Contains(t, legacyT.buf.String(), "Messages:\tcase 2\n")
+
+	failT := new(failNowT)
+	contextT := WithContext(failT, "case %d", 3)
+	if f, ok := contextT.(failNower); !ok {
+		t.Error("WithContext should keep the FailNow method")
+	} else {
+		f.FailNow()
+		True(t, failT.failedNow)
+	}
+	if _, ok := WithContext(new(helperT), "case").(tHelper); !ok {
+		t.Error("WithContext should keep the Helper method")
+	}
+}
@@ -0,0 +1,3 @@
+module hawx.me/code/assert
+
+go 1.21
@@ -3,11 +3,18 @@ package assert
 import (
 	"bufio"
 	"bytes"
+	"cmp"
 	"fmt"
+	"math"
+	"math/big"
+	"path/filepath"
 	"reflect"
 	"regexp"
 	"runtime"
+	"runtime/debug"
+	"sort"
 	"strings"
+	"sync"
 	"time"
 	"unicode"
 	"unicode/utf8"
@@ -17,15 +24,11 @@ import (
 //
 // This function does no assertion of any kind.
 func objectsAreEqual(expected, actual interface{}) bool {
-	if expected == nil || actual == nil {
-		return expected == actual
+	if expected == nil {
+		return actual == nil
 	}
 
-	if reflect.DeepEqual(expected, actual) {
-		return true
-	}
-
-	return false
+	return newDiffer(false).walk("", reflect.ValueOf(expected), reflect.ValueOf(actual))
 }
 
 // objectsAreEquivalent gets whether two objects are equal, or if their
@@ -39,7 +42,7 @@ func objectsAreEquivalent(expected, actual interface{}) bool {
 	expectedValue := reflect.ValueOf(expected)
 	if expectedValue.Type().ConvertibleTo(actualType) {
 		// Attempt comparison after type conversion
-		if reflect.DeepEqual(actual, expectedValue.Convert(actualType).Interface()) {
+		if objectsAreEqual(expectedValue.Convert(actualType).Interface(), actual) {
 			return true
 		}
 	}
@@ -51,42 +54,79 @@ func objectsAreEquivalent(expected, actual interface{}) bool {
 internally, causing it to print the file:line of the assert method, rather than where
 the problem actually occured in calling code.*/
 
+var (
+	hiddenPackagesMu sync.RWMutex
+	hiddenPackages   = map[string]bool{
+		reflect.TypeOf(visit{}).PkgPath(): true,
+	}
+)
+
+// HidePackage stops frames from functions in the package with the given import
+// path appearing in the Error Trace of failures. Packages of helpers that make
+// assertions on behalf of a test should call this from an init function, so
+// that failures point at the test instead.
+func HidePackage(path string) {
+	hiddenPackagesMu.Lock()
+	hiddenPackages[path] = true
+	hiddenPackagesMu.Unlock()
+}
+
+func isHiddenPackage(path string) bool {
+	hiddenPackagesMu.RLock()
+	defer hiddenPackagesMu.RUnlock()
+	return hiddenPackages[path]
+}
+
+// funcPackage splits a fully qualified function name, as given by
+// runtime.Frame, into its package path and the name within the package. The
+// runtime escapes dots in the last element of the path as "%2e", so they are
+// unescaped to give the path that HidePackage is called with.
+func funcPackage(function string) (pkg, name string) {
+	slash := strings.LastIndex(function, "/")
+	dot := strings.Index(function[slash+1:], ".")
+	if dot < 0 {
+		return "", function
+	}
+
+	pkg = strings.Replace(function[:slash+1+dot], "%2e", ".", -1)
+	return pkg, function[slash+1+dot+1:]
+}
+
 // CallerInfo returns an array of strings containing the file and line number
 // of each stack frame leading from the current test to the assert call that
 // failed.
 func callerInfo() []string {
 	callers := []string{}
 
-	for i := 0; ; i++ {
-		pc, file, line, ok := runtime.Caller(i)
-		if !ok {
-			return nil
-		}
+	pcs := make([]uintptr, 64)
+	frames := runtime.CallersFrames(pcs[:runtime.Callers(1, pcs)])
+
+	for {
+		frame, more := frames.Next()
 
 		// This is a huge edge case, but it will panic if this is the case, see #180
-		if file == "<autogenerated>" {
+		if frame.File == "<autogenerated>" {
 			break
 		}
 
-		parts := strings.Split(file, "/")
-		dir := parts[len(parts)-2]
-		file = parts[len(parts)-1]
-		if (dir != "assert" && dir != "mock" && dir != "require") || file == "mock_test.go" {
-			callers = append(callers, fmt.Sprintf("%s:%d", file, line))
+		// Frames from the runtime, such as runtime.goexit at the bottom of a
+		// goroutine started by poll, are never part of the trace
+		pkg, name := funcPackage(frame.Function)
+		file := filepath.Base(frame.File)
+		if pkg != "runtime" && (!isHiddenPackage(pkg) || strings.HasSuffix(file, "_test.go")) {
+			callers = append(callers, fmt.Sprintf("%s:%d", file, frame.Line))
 		}
 
-		f := runtime.FuncForPC(pc)
-		if f == nil {
-			break
-		}
-
-		name := f.Name()
-		// Drop the package
+		// Drop any receiver or closure names
 		segments := strings.Split(name, ".")
 		name = segments[len(segments)-1]
 		if isTest(name, "Test") || isTest(name, "Benchmark") || isTest(name, "Example") {
 			break
 		}
+
+		if !more {
+			break
+		}
 	}
 
 	return callers
@@ -121,14 +161,63 @@ func getWhitespaceString() string {
 	return strings.Repeat(" ", len(fmt.Sprintf("%s:%d:      ", file, line)))
 }
 
+// messageFromMsgAndArgs returns the message to show for the msgAndArgs passed
+// to an assertion. A string is used as a format for the arguments after it, and
+// a func() string is only called now that the message is needed. Other values
+// are formatted with %+v.
 func messageFromMsgAndArgs(msgAndArgs ...interface{}) string {
+	if len(msgAndArgs) == 0 {
+		return ""
+	}
+
+	var format string
+	switch msg := msgAndArgs[0].(type) {
+	case string:
+		format = msg
+	case func() string:
+		format = msg()
+	default:
+		parts := make([]string, len(msgAndArgs))
+		for i, arg := range msgAndArgs {
+			parts[i] = fmt.Sprintf("%+v", arg)
+		}
+		return strings.Join(parts, " ")
+	}
+
 	if len(msgAndArgs) == 1 {
-		return msgAndArgs[0].(string)
+		return format
+	}
+
+	return fmt.Sprintf(format, msgAndArgs[1:]...)
+}
+
+// splitKV removes a KV from the end of msgAndArgs, if there is one.
+func splitKV(msgAndArgs []interface{}) ([]interface{}, KV) {
+	if n := len(msgAndArgs); n > 0 {
+		if kv, ok := msgAndArgs[n-1].(KV); ok {
+			return msgAndArgs[:n-1], kv
+		}
 	}
-	if len(msgAndArgs) > 1 {
-		return fmt.Sprintf(msgAndArgs[0].(string), msgAndArgs[1:]...)
+
+	return msgAndArgs, nil
+}
+
+// formatKV formats kv as a table, one line for each key in order.
+func formatKV(kv KV) string {
+	keys := make([]string, 0, len(kv))
+	width := 0
+	for key := range kv {
+		keys = append(keys, key)
+		width = max(width, len(key)+1)
 	}
-	return ""
+	sort.Strings(keys)
+
+	lines := make([]string, len(keys))
+	for i, key := range keys {
+		lines[i] = fmt.Sprintf("%-*s %+v", width, key+":", kv[key])
+	}
+
+	return strings.Join(lines, "\n")
 }
 
 // Indents all lines of the message by appending a number of tabs to each line, in an output format compatible with Go's
@@ -228,50 +317,300 @@ func getLen(x interface{}) (ok bool, length int) {
 	return true, v.Len()
 }
 
-// containsElement try loop over the list check if the list includes the element.
+// includeElement try loop over the list check if the list includes the element,
+// or for a map check if the element is one of its keys.
 // return (false, false) if impossible.
 // return (true, false) if element was not found.
 // return (true, true) if element was found.
 func includeElement(list interface{}, element interface{}) (ok, found bool) {
+	result, err := searchList(list, element)
+	if err != nil {
+		return false, false
+	}
+
+	return true, result.found
+}
+
+// searchResult describes how searchList looked for an element, so that Contains
+// and NotContains can explain their failures.
+type searchResult struct {
+	found bool
+
+	// subject and relation make up the failure message, for example the subject
+	// `"Hello"` and relation `contain "World"`.
+	subject  string
+	relation string
+}
+
+// searchList looks for element in list. Strings, fmt.Stringers and []byte are
+// searched for a substring, maps for a key, arrays and slices for an element, and
+// channels for a value that is currently buffered.
+func searchList(list interface{}, element interface{}) (result searchResult, err error) {
+	defer func() {
+		if e := recover(); e != nil {
+			result, err = searchResult{}, fmt.Errorf("cannot look for %#v in %#v: %v", element, list, e)
+		}
+	}()
+
 	listValue := reflect.ValueOf(list)
 	elementValue := reflect.ValueOf(element)
+
+	switch listValue.Kind() {
+	case reflect.String:
+		if elementValue.Kind() != reflect.String {
+			return searchResult{}, fmt.Errorf("%#v is a string, so can only contain a string, not %T", list, element)
+		}
+
+		return searchResult{
+			found:    strings.Contains(listValue.String(), elementValue.String()),
+			subject:  fmt.Sprintf("%q", listValue.String()),
+			relation: fmt.Sprintf("contain %q", elementValue.String()),
+		}, nil
+
+	case reflect.Map:
+		_, found := mapIndex(listValue, elementValue)
+
+		return searchResult{
+			found:    found,
+			subject:  fmt.Sprintf("%#v", list),
+			relation: fmt.Sprintf("have key %#v", element),
+		}, nil
+
+	case reflect.Slice:
+		if listValue.Type().Elem().Kind() == reflect.Uint8 {
+			return searchBytes(listValue.Bytes(), element)
+		}
+
+		return searchElements(listValue, listValue, element), nil
+
+	case reflect.Array:
+		return searchElements(listValue, listValue, element), nil
+
+	case reflect.Chan:
+		if listValue.Type().ChanDir() != reflect.BothDir {
+			return searchResult{}, fmt.Errorf("%T must be able to send and receive to look at its buffered values", list)
+		}
+
+		values, err := snapshotChan(listValue)
+		if err != nil {
+			return searchResult{}, err
+		}
+
+		return searchElements(listValue, values, element), nil
+	}
+
+	if stringer, ok := list.(fmt.Stringer); ok {
+		if elementValue.Kind() != reflect.String {
+			return searchResult{}, fmt.Errorf("%T is a fmt.Stringer, so can only contain a string, not %T", list, element)
+		}
+
+		str := stringer.String()
+
+		return searchResult{
+			found:    strings.Contains(str, elementValue.String()),
+			subject:  fmt.Sprintf("%T with String() %q", list, str),
+			relation: fmt.Sprintf("contain %q", elementValue.String()),
+		}, nil
+	}
+
+	return searchResult{}, fmt.Errorf("%#v is not a string, array, slice, map, channel or fmt.Stringer", list)
+}
+
+// searchBytes looks for a single byte, or a sub-slice given as a []byte or
+// string, in list.
+func searchBytes(list []byte, element interface{}) (searchResult, error) {
+	var sub []byte
+
+	switch e := element.(type) {
+	case byte:
+		sub = []byte{e}
+	case []byte:
+		sub = e
+	case string:
+		sub = []byte(e)
+	default:
+		return searchResult{}, fmt.Errorf("%#v is a []byte, so can only contain a byte, []byte or string, not %T", list, element)
+	}
+
+	return searchResult{
+		found:    bytes.Contains(list, sub),
+		subject:  fmt.Sprintf("%#v", list),
+		relation: fmt.Sprintf("contain %#v", element),
+	}, nil
+}
+
+// searchElements looks for element in values, an array or slice, describing
+// list as the subject.
+func searchElements(list, values reflect.Value, element interface{}) searchResult {
+	result := searchResult{
+		subject:  formatValue(list),
+		relation: fmt.Sprintf("contain %#v", element),
+	}
+	if list.Kind() == reflect.Chan {
+		result.subject = fmt.Sprintf("%v with buffered values %s", list.Type(), formatValue(values))
+	}
+
+	for i := 0; i < values.Len(); i++ {
+		if objectsAreEqual(element, values.Index(i).Interface()) {
+			result.found = true
+			break
+		}
+	}
+
+	return result
+}
+
+// snapshotChan returns the values currently buffered in the channel ch as a
+// slice, sending them back so that ch is left as it was. This is racy: values
+// sent or received by other goroutines while it happens may be missed or
+// reordered, so it should only be used on a channel that nothing else is using.
+//
+// Values received from a closed channel could not be sent back, so an error is
+// returned instead when ch is closed and still has values buffered.
+func snapshotChan(ch reflect.Value) (reflect.Value, error) {
+	values := reflect.MakeSlice(reflect.SliceOf(ch.Type().Elem()), 0, ch.Len())
+	if ch.Len() == 0 {
+		return values, nil
+	}
+
+	// Sending panics when ch is closed, so a zero value is sent first to check.
+	// If there was room for it, it is received last and not sent back.
+	probed, err := trySend(ch, reflect.Zero(ch.Type().Elem()))
+	if err != nil {
+		return values, err
+	}
+
+	for n := ch.Len(); n > 0; n-- {
+		value, ok := ch.TryRecv()
+		if !ok {
+			break
+		}
+		values = reflect.Append(values, value)
+	}
+	if probed && values.Len() > 0 {
+		values = values.Slice(0, values.Len()-1)
+	}
+
+	for i := 0; i < values.Len(); i++ {
+		ch.TrySend(values.Index(i))
+	}
+
+	return values, nil
+}
+
+// trySend attempts to send value on ch without blocking, returning an error
+// rather than panicking when ch is closed.
+func trySend(ch, value reflect.Value) (sent bool, err error) {
 	defer func() {
 		if e := recover(); e != nil {
-			ok = false
-			found = false
+			sent, err = false, fmt.Errorf("%v is closed, so its buffered values cannot be looked at without receiving them", ch.Type())
 		}
 	}()
 
-	if reflect.TypeOf(list).Kind() == reflect.String {
-		return true, strings.Contains(listValue.String(), elementValue.String())
+	return ch.TrySend(value), nil
+}
+
+// mapIndex returns the value stored in the map m for key. When key is not of the
+// map's key type, or can't be hashed, each key is compared with objectsAreEqual
+// instead.
+func mapIndex(m, key reflect.Value) (reflect.Value, bool) {
+	if key.IsValid() && key.Comparable() && key.Type().AssignableTo(m.Type().Key()) {
+		value := m.MapIndex(key)
+		return value, value.IsValid()
 	}
 
-	for i := 0; i < listValue.Len(); i++ {
-		if objectsAreEqual(listValue.Index(i).Interface(), element) {
-			return true, true
+	var element interface{}
+	if key.IsValid() {
+		element = key.Interface()
+	}
+
+	iter := m.MapRange()
+	for iter.Next() {
+		if objectsAreEqual(iter.Key().Interface(), element) {
+			return iter.Value(), true
 		}
 	}
 
-	return true, false
+	return reflect.Value{}, false
 }
 
-// didPanic returns true if the function passed to it panics. Otherwise, it returns false.
-func didPanic(f func()) (bool, interface{}) {
-	didPanic := false
+// didPanic returns true if the function passed to it panics. Otherwise, it
+// returns false. When it panics, the recovered value and the stack trace of the
+// panic are also returned.
+func didPanic(f func()) (bool, interface{}, string) {
+	didPanic := true
 	var message interface{}
+	var stack string
 
 	func() {
 		defer func() {
-			if message = recover(); message != nil {
-				didPanic = true
+			if didPanic {
+				message = recover()
+				stack = string(debug.Stack())
 			}
 		}()
 
 		// call the target function
 		f()
+		didPanic = false
 	}()
 
-	return didPanic, message
+	return didPanic, message, stack
+}
+
+// pollResult is the outcome of a single call to the Comparison being polled.
+type pollResult struct {
+	result     bool
+	panicValue interface{}
+	panicked   bool
+}
+
+// poll calls comp every tick until it returns until, or waitFor has elapsed.
+// It returns the number of times comp was called, whether it returned until,
+// and whether the last call was still running when waitFor elapsed. Each call
+// is made in its own goroutine so that a call that blocks is given at most a
+// tick longer than waitFor; a call that does not return is abandoned, and its
+// goroutine is left running. A non-positive tick is treated as defaultTick.
+func poll(comp Comparison, waitFor, tick time.Duration, until bool) (polls int, found, blocked bool) {
+	if tick <= 0 {
+		tick = defaultTick
+	}
+	deadline := time.Now().Add(waitFor)
+
+	for polls = 1; ; polls++ {
+		results := make(chan pollResult, 1)
+		go func() {
+			var r pollResult
+			defer func() {
+				if r.panicValue = recover(); r.panicValue != nil {
+					r.panicked = true
+				}
+				results <- r
+			}()
+
+			r.result = comp()
+		}()
+
+		timeout := time.NewTimer(max(time.Until(deadline), tick))
+		select {
+		case r := <-results:
+			timeout.Stop()
+			if r.panicked {
+				panic(r.panicValue)
+			}
+			if r.result == until {
+				return polls, true, false
+			}
+		case <-timeout.C:
+			return polls, false, true
+		}
+
+		remaining := time.Until(deadline)
+		if remaining <= 0 {
+			return polls, false, false
+		}
+		time.Sleep(min(remaining, tick))
+	}
 }
 
 func toFloat(x interface{}) (float64, bool) {
@@ -308,6 +647,167 @@ func toFloat(x interface{}) (float64, bool) {
 	return xf, xok
 }
 
+// compareOrdered compares x and y, which must be of the same type, returning -1,
+// 0 or +1 as x is less than, equal to, or greater than y. Numbers, strings,
+// time.Time and big.Int can be compared.
+func compareOrdered(x, y interface{}) (int, error) {
+	if x == nil || y == nil {
+		return 0, fmt.Errorf("Cannot compare %#v and %#v", x, y)
+	}
+
+	xv, yv := reflect.ValueOf(x), reflect.ValueOf(y)
+	if xv.Type() != yv.Type() {
+		return 0, fmt.Errorf("Cannot compare %T and %T, they must be the same type", x, y)
+	}
+
+	switch xn := x.(type) {
+	case time.Time:
+		return xn.Compare(y.(time.Time)), nil
+	case big.Int:
+		yn := y.(big.Int)
+		return xn.Cmp(&yn), nil
+	case *big.Int:
+		if xn == nil || y.(*big.Int) == nil {
+			return 0, fmt.Errorf("Cannot compare %v and %v", x, y)
+		}
+		return xn.Cmp(y.(*big.Int)), nil
+	}
+
+	switch xv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return cmp.Compare(xv.Int(), yv.Int()), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return cmp.Compare(xv.Uint(), yv.Uint()), nil
+	case reflect.Float32, reflect.Float64:
+		if math.IsNaN(xv.Float()) || math.IsNaN(yv.Float()) {
+			return 0, fmt.Errorf("Cannot compare %v and %v, NaN is not ordered", x, y)
+		}
+		return cmp.Compare(xv.Float(), yv.Float()), nil
+	case reflect.String:
+		return strings.Compare(xv.String(), yv.String()), nil
+	}
+
+	return 0, fmt.Errorf("Cannot compare values of type %T", x)
+}
+
+// sign returns -1, 0 or +1 as x is negative, zero or positive. Numbers,
+// time.Duration and big.Int have a sign.
+func sign(x interface{}) (int, error) {
+	switch xn := x.(type) {
+	case big.Int:
+		return xn.Sign(), nil
+	case *big.Int:
+		if xn != nil {
+			return xn.Sign(), nil
+		}
+	}
+
+	xv := reflect.ValueOf(x)
+	switch xv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return cmp.Compare(xv.Int(), 0), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return cmp.Compare(xv.Uint(), 0), nil
+	case reflect.Float32, reflect.Float64:
+		if math.IsNaN(xv.Float()) {
+			return 0, fmt.Errorf("NaN does not have a sign")
+		}
+		return cmp.Compare(xv.Float(), 0), nil
+	}
+
+	return 0, fmt.Errorf("Cannot check the sign of %#v", x)
+}
+
+// formatOperand formats a value compared by the ordering assertions.
+func formatOperand(x interface{}) string {
+	if xn, ok := x.(big.Int); ok {
+		return xn.String()
+	}
+	if x != nil && reflect.TypeOf(x).Kind() == reflect.String {
+		return fmt.Sprintf("%q", x)
+	}
+
+	return fmt.Sprintf("%v", x)
+}
+
+// compareTwo asserts that the result of comparing e1 with e2 is allowed, where
+// relation describes what e1 should be relative to e2.
+func compareTwo(t TestingT, e1, e2 interface{}, allowed func(int) bool, relation string, msgAndArgs ...interface{}) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+
+	result, err := compareOrdered(e1, e2)
+	if err != nil {
+		return failMisuse(t, err.Error(), msgAndArgs...)
+	}
+	if !allowed(result) {
+		return Fail(t, fmt.Sprintf("%s is not %s %s", formatOperand(e1), relation, formatOperand(e2)), msgAndArgs...)
+	}
+
+	return true
+}
+
+// isZero returns whether x is zero: a number that equals 0, a value whose
+// IsZero method returns true, or otherwise the zero value of its type.
+func isZero(x interface{}) bool {
+	if s, err := sign(x); err == nil {
+		return s == 0
+	}
+	if z, ok := x.(interface{ IsZero() bool }); ok {
+		return z.IsZero()
+	}
+
+	return x == nil || reflect.ValueOf(x).IsZero()
+}
+
+// checkSign asserts that the sign of e is allowed, where description is what e
+// should be.
+func checkSign(t TestingT, e interface{}, allowed func(int) bool, description string, msgAndArgs ...interface{}) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+
+	result, err := sign(e)
+	if err != nil {
+		return failMisuse(t, err.Error(), msgAndArgs...)
+	}
+	if !allowed(result) {
+		return Fail(t, fmt.Sprintf("%s is not %s", formatOperand(e), description), msgAndArgs...)
+	}
+
+	return true
+}
+
+// checkOrder asserts that each element of the list is allowed when compared with
+// the element before it, where relation describes what each element should be
+// relative to the one before.
+func checkOrder(t TestingT, list interface{}, allowed func(int) bool, name, relation string, msgAndArgs ...interface{}) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+
+	listValue := reflect.ValueOf(list)
+	if !isList(listValue) {
+		return failMisuse(t, fmt.Sprintf("%#v is not an array or slice", list), msgAndArgs...)
+	}
+
+	for i := 1; i < listValue.Len(); i++ {
+		prev, next := listValue.Index(i-1).Interface(), listValue.Index(i).Interface()
+
+		result, err := compareOrdered(next, prev)
+		if err != nil {
+			return failMisuse(t, err.Error(), msgAndArgs...)
+		}
+		if !allowed(result) {
+			return Fail(t, fmt.Sprintf("Not %s: [%d] %s is not %s [%d] %s",
+				name, i, formatOperand(next), relation, i-1, formatOperand(prev)), msgAndArgs...)
+		}
+	}
+
+	return true
+}
+
 // min(|expected|, |actual|) * epsilon
 func calcEpsilonDelta(expected, actual interface{}, epsilon float64) float64 {
 	af, aok := toFloat(expected)
@@ -348,10 +848,14 @@ func matchRegexp(rx interface{}, str interface{}) bool {
 
 func inSlice(f func(t TestingT, expected, actual interface{}, val float64, msgAndArgs ...interface{}) bool) func(t TestingT, expected, actual interface{}, val float64, msgAndArgs ...interface{}) bool {
 	return func(t TestingT, expected, actual interface{}, val float64, msgAndArgs ...interface{}) bool {
+		if h, ok := t.(tHelper); ok {
+			h.Helper()
+		}
+
 		if expected == nil || actual == nil ||
 			reflect.TypeOf(actual).Kind() != reflect.Slice ||
 			reflect.TypeOf(expected).Kind() != reflect.Slice {
-			return Fail(t, fmt.Sprintf("Parameters must be slice"), msgAndArgs...)
+			return failMisuse(t, fmt.Sprintf("Parameters must be slice"), msgAndArgs...)
 		}
 
 		actualSlice := reflect.ValueOf(actual)
@@ -367,3 +871,115 @@ func inSlice(f func(t TestingT, expected, actual interface{}, val float64, msgAn
 		return true
 	}
 }
+
+// errorChain describes err and every error it wraps, one per line, indenting
+// each error beneath the error that wraps it. Errors that wrap multiple
+// errors, like those from errors.Join, list each of them.
+func errorChain(err error) string {
+	lines := []string{}
+
+	var walk func(err error, depth int)
+	walk = func(err error, depth int) {
+		lines = append(lines, fmt.Sprintf("%s%T: %q", strings.Repeat("  ", depth), err, err.Error()))
+
+		switch wrapped := err.(type) {
+		case interface{ Unwrap() error }:
+			if inner := wrapped.Unwrap(); inner != nil {
+				walk(inner, depth+1)
+			}
+		case interface{ Unwrap() []error }:
+			for _, inner := range wrapped.Unwrap() {
+				if inner != nil {
+					walk(inner, depth+1)
+				}
+			}
+		}
+	}
+
+	if err == nil {
+		return "<nil>"
+	}
+	walk(err, 0)
+
+	return strings.Join(lines, "\n")
+}
+
+// isList returns true if v is an array or slice.
+func isList(v reflect.Value) bool {
+	return v.Kind() == reflect.Array || v.Kind() == reflect.Slice
+}
+
+// callerName returns the name of the function skip frames above the caller of
+// callerName, without its package or receiver.
+func callerName(skip int) string {
+	pc, _, _, ok := runtime.Caller(skip + 1)
+	if !ok {
+		return ""
+	}
+
+	name := runtime.FuncForPC(pc).Name()
+	return name[strings.LastIndex(name, ".")+1:]
+}
+
+var errorType = reflect.TypeOf((*error)(nil)).Elem()
+
+// indirect follows v through any pointers, stopping at a nil pointer.
+func indirect(v reflect.Value) reflect.Value {
+	for v.Kind() == reflect.Ptr && !v.IsNil() {
+		v = v.Elem()
+	}
+
+	return v
+}
+
+// isNilValue returns true if v is nil, or a nil pointer.
+func isNilValue(v reflect.Value) bool {
+	return !v.IsValid() || (v.Kind() == reflect.Ptr && v.IsNil())
+}
+
+// formatArgs formats args as they would be written in a call.
+func formatArgs(args []interface{}) string {
+	formatted := make([]string, len(args))
+	for i, arg := range args {
+		formatted[i] = fmt.Sprintf("%#v", arg)
+	}
+
+	return strings.Join(formatted, ", ")
+}
+
+// callArgs converts args to the values to call a function of type fnType with,
+// returning an error if they do not match its parameters.
+func callArgs(fnType reflect.Type, args []interface{}) ([]reflect.Value, error) {
+	numIn := fnType.NumIn()
+	if fnType.IsVariadic() {
+		if len(args) < numIn-1 {
+			return nil, fmt.Errorf("called with %d arguments, but it takes at least %d", len(args), numIn-1)
+		}
+	} else if len(args) != numIn {
+		return nil, fmt.Errorf("called with %d arguments, but it takes %d", len(args), numIn)
+	}
+
+	in := make([]reflect.Value, len(args))
+	for i, arg := range args {
+		paramType := fnType.In(min(i, numIn-1))
+		if fnType.IsVariadic() && i >= numIn-1 {
+			paramType = paramType.Elem()
+		}
+
+		if arg == nil {
+			switch paramType.Kind() {
+			case reflect.Chan, reflect.Func, reflect.Interface, reflect.Map, reflect.Ptr, reflect.Slice:
+				in[i] = reflect.Zero(paramType)
+				continue
+			}
+		}
+
+		value := reflect.ValueOf(arg)
+		if !value.IsValid() || !value.Type().AssignableTo(paramType) {
+			return nil, fmt.Errorf("argument %d is %T, but should be %v", i+1, arg, paramType)
+		}
+		in[i] = value
+	}
+
+	return in, nil
+}
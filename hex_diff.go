@@ -0,0 +1,151 @@
+package assert
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"unicode/utf8"
+)
+
+const (
+	// hexRowWidth is the number of bytes shown in each row of a hexdump.
+	hexRowWidth = 16
+
+	// hexWindow is the number of rows shown either side of the row containing
+	// the first difference.
+	hexWindow = 2
+)
+
+// bytesOf returns the value of object if it is a slice of bytes.
+func bytesOf(object interface{}) ([]byte, bool) {
+	if object == nil {
+		return nil, false
+	}
+
+	value := reflect.ValueOf(object)
+	if value.Kind() != reflect.Slice || value.Type().Elem().Kind() != reflect.Uint8 {
+		return nil, false
+	}
+
+	return value.Bytes(), true
+}
+
+// isBinary returns true if expected and actual are both slices of bytes, and at
+// least one of them is not valid UTF-8.
+func isBinary(expected, actual interface{}) ([]byte, []byte, bool) {
+	e, eok := bytesOf(expected)
+	a, aok := bytesOf(actual)
+	if !eok || !aok {
+		return nil, nil, false
+	}
+
+	return e, a, !utf8.Valid(e) || !utf8.Valid(a)
+}
+
+// firstDifference returns the offset of the first byte that differs between
+// expected and actual, or -1 if they are the same.
+func firstDifference(expected, actual []byte) int {
+	for i := 0; i < len(expected) && i < len(actual); i++ {
+		if expected[i] != actual[i] {
+			return i
+		}
+	}
+
+	if len(expected) != len(actual) {
+		if len(expected) < len(actual) {
+			return len(expected)
+		}
+		return len(actual)
+	}
+
+	return -1
+}
+
+// hexDiff returns aligned hexdumps of the rows around the first difference
+// between expected and actual, with the differing byte marked.
+func hexDiff(expected, actual []byte) string {
+	offset := firstDifference(expected, actual)
+	if offset < 0 {
+		return ""
+	}
+
+	lines := []string{
+		fmt.Sprintf("first difference at offset %#x (%d), expected %d bytes, actual %d bytes",
+			offset, offset, len(expected), len(actual)),
+	}
+
+	length := len(expected)
+	if len(actual) > length {
+		length = len(actual)
+	}
+
+	diffRow := offset / hexRowWidth
+	fromRow := diffRow - hexWindow
+	if fromRow < 0 {
+		fromRow = 0
+	}
+	toRow := diffRow + hexWindow
+	if last := (length - 1) / hexRowWidth; toRow > last {
+		toRow = last
+	}
+
+	if fromRow > 0 {
+		lines = append(lines, "  ...")
+	}
+	for row := fromRow; row <= toRow; row++ {
+		expectedRow := hexRow(expected, row*hexRowWidth)
+		actualRow := hexRow(actual, row*hexRowWidth)
+
+		if expectedRow == actualRow {
+			lines = append(lines, "  "+expectedRow)
+		} else {
+			lines = append(lines, "- "+expectedRow, "+ "+actualRow)
+		}
+
+		if row == diffRow {
+			lines = append(lines, strings.Repeat(" ", hexColumn(offset%hexRowWidth))+"^^")
+		}
+	}
+	if (toRow+1)*hexRowWidth < length {
+		lines = append(lines, "  ...")
+	}
+
+	return "    " + strings.Join(lines, "\n    ")
+}
+
+// hexRow formats the row of b starting at offset, padding bytes past the end
+// of b with spaces so that rows of different values align.
+func hexRow(b []byte, offset int) string {
+	var hex, text strings.Builder
+
+	for i := 0; i < hexRowWidth; i++ {
+		if i == hexRowWidth/2 {
+			hex.WriteByte(' ')
+		}
+
+		if offset+i >= len(b) {
+			hex.WriteString("   ")
+			continue
+		}
+
+		c := b[offset+i]
+		fmt.Fprintf(&hex, "%02x ", c)
+		if c >= 0x20 && c < 0x7f {
+			text.WriteByte(c)
+		} else {
+			text.WriteByte('.')
+		}
+	}
+
+	return fmt.Sprintf("%08x  %s |%s|", offset, hex.String(), text.String())
+}
+
+// hexColumn returns the column at which the byte at index i of a row starts,
+// including the two character prefix added by hexDiff.
+func hexColumn(i int) int {
+	column := 2 + 10 + i*3
+	if i >= hexRowWidth/2 {
+		column++
+	}
+	return column
+}
@@ -0,0 +1,42 @@
+package assert
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestFirstDifference(t *testing.T) {
+	Equal(t, -1, firstDifference([]byte{1, 2}, []byte{1, 2}))
+	Equal(t, 1, firstDifference([]byte{1, 2}, []byte{1, 3}))
+	Equal(t, 2, firstDifference([]byte{1, 2}, []byte{1, 2, 3}))
+	Equal(t, 0, firstDifference([]byte{}, []byte{1}))
+}
+
+func TestHexDiff(t *testing.T) {
+	expected := make([]byte, 128)
+	for i := range expected {
+		expected[i] = byte(i)
+	}
+	actual := append([]byte{}, expected...)
+	actual[0x49] = 0xff
+
+	Equal(t, strings.Join([]string{
+		"    first difference at offset 0x49 (73), expected 128 bytes, actual 128 bytes",
+		"      ...",
+		"      00000020  20 21 22 23 24 25 26 27  28 29 2a 2b 2c 2d 2e 2f  | !\"#$%&'()*+,-./|",
+		"      00000030  30 31 32 33 34 35 36 37  38 39 3a 3b 3c 3d 3e 3f  |0123456789:;<=>?|",
+		"    - 00000040  40 41 42 43 44 45 46 47  48 49 4a 4b 4c 4d 4e 4f  |@ABCDEFGHIJKLMNO|",
+		"    + 00000040  40 41 42 43 44 45 46 47  48 ff 4a 4b 4c 4d 4e 4f  |@ABCDEFGH.JKLMNO|",
+		"                                            ^^",
+		"      00000050  50 51 52 53 54 55 56 57  58 59 5a 5b 5c 5d 5e 5f  |PQRSTUVWXYZ[\\]^_|",
+		"      00000060  60 61 62 63 64 65 66 67  68 69 6a 6b 6c 6d 6e 6f  |`abcdefghijklmno|",
+		"      ...",
+	}, "\n"), hexDiff(expected, actual))
+}
+
+func TestEqualReportsHexDiff(t *testing.T) {
+	mockT := new(bufferT)
+	Equal(mockT, []byte{0x00, 0x01, 0xff}, []byte{0x00, 0x02, 0xff})
+	Contains(t, mockT.buf.String(), "first difference at offset 0x1 (1)")
+	Contains(t, mockT.buf.String(), "+ 00000000  00 02 ff")
+}
@@ -0,0 +1,222 @@
+package assert
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"unicode/utf8"
+)
+
+// diffContext is the number of unchanged lines shown around each change in a
+// line diff.
+const diffContext = 3
+
+// maxDiffCells limits the size of the table used to diff the lines that differ,
+// after any common prefix and suffix, so that diffing large texts stays cheap.
+const maxDiffCells = 1 << 20
+
+// lineOp is a single line of a line diff: ' ' for a line in both, '-' for a
+// line only in expected and '+' for a line only in actual. Line numbers are
+// 1-based, or 0 where the line does not appear on that side.
+type lineOp struct {
+	kind                     byte
+	expectedLine, actualLine int
+	text                     string
+}
+
+// textOf returns the value of object as text if it is a string, or a slice of
+// bytes containing valid UTF-8.
+func textOf(object interface{}) (string, bool) {
+	if object == nil {
+		return "", false
+	}
+
+	value := reflect.ValueOf(object)
+	switch value.Kind() {
+	case reflect.String:
+		return value.String(), true
+	case reflect.Slice:
+		if value.Type().Elem().Kind() == reflect.Uint8 {
+			b := value.Bytes()
+			if utf8.Valid(b) {
+				return string(b), true
+			}
+		}
+	}
+
+	return "", false
+}
+
+// isMultiline returns true if expected and actual are both text of the same
+// type, and at least one of them spans more than a single line.
+func isMultiline(expected, actual interface{}) (string, string, bool) {
+	if reflect.TypeOf(expected) != reflect.TypeOf(actual) {
+		return "", "", false
+	}
+
+	e, eok := textOf(expected)
+	a, aok := textOf(actual)
+	if !eok || !aok {
+		return "", "", false
+	}
+
+	return e, a, strings.Contains(e, "\n") || strings.Contains(a, "\n")
+}
+
+// diffLines finds the longest common subsequence of the two sets of lines and
+// returns the operations that turn expected into actual. It returns false if,
+// after removing the lines they start and end with in common, there are too
+// many lines left to diff.
+func diffLines(expected, actual []string) ([]lineOp, bool) {
+	prefix := 0
+	for prefix < len(expected) && prefix < len(actual) && expected[prefix] == actual[prefix] {
+		prefix++
+	}
+	suffix := 0
+	for suffix < len(expected)-prefix && suffix < len(actual)-prefix &&
+		expected[len(expected)-1-suffix] == actual[len(actual)-1-suffix] {
+		suffix++
+	}
+
+	ops := make([]lineOp, 0, len(expected)+len(actual)-prefix-suffix)
+	for i := 0; i < prefix; i++ {
+		ops = append(ops, lineOp{' ', i + 1, i + 1, expected[i]})
+	}
+
+	middle, ok := diffMiddle(expected[prefix:len(expected)-suffix], actual[prefix:len(actual)-suffix], prefix)
+	if !ok {
+		return nil, false
+	}
+	ops = append(ops, middle...)
+
+	for k := suffix; k > 0; k-- {
+		i, j := len(expected)-k, len(actual)-k
+		ops = append(ops, lineOp{' ', i + 1, j + 1, expected[i]})
+	}
+
+	return ops, true
+}
+
+// diffMiddle diffs the lines left once the common prefix and suffix have been
+// removed, numbering them from offset.
+func diffMiddle(expected, actual []string, offset int) ([]lineOp, bool) {
+	n, m := len(expected), len(actual)
+	if (n+1)*(m+1) > maxDiffCells {
+		return nil, false
+	}
+
+	// lcs[i][j] is the length of the longest common subsequence of
+	// expected[i:] and actual[j:].
+	lcs := make([][]int, n+1)
+	for i := range lcs {
+		lcs[i] = make([]int, m+1)
+	}
+	for i := n - 1; i >= 0; i-- {
+		for j := m - 1; j >= 0; j-- {
+			if expected[i] == actual[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else if lcs[i+1][j] >= lcs[i][j+1] {
+				lcs[i][j] = lcs[i+1][j]
+			} else {
+				lcs[i][j] = lcs[i][j+1]
+			}
+		}
+	}
+
+	ops := []lineOp{}
+	i, j := 0, 0
+	for i < n || j < m {
+		switch {
+		case i < n && j < m && expected[i] == actual[j]:
+			ops = append(ops, lineOp{' ', offset + i + 1, offset + j + 1, expected[i]})
+			i++
+			j++
+		case j == m || (i < n && lcs[i+1][j] >= lcs[i][j+1]):
+			ops = append(ops, lineOp{'-', offset + i + 1, 0, expected[i]})
+			i++
+		default:
+			ops = append(ops, lineOp{'+', 0, offset + j + 1, actual[j]})
+			j++
+		}
+	}
+
+	return ops, true
+}
+
+// unifiedDiff returns a unified diff of the lines in expected and actual, with
+// line numbers for each side. It returns false if they are too large to diff.
+func unifiedDiff(expected, actual string) (string, bool) {
+	ops, ok := diffLines(strings.Split(expected, "\n"), strings.Split(actual, "\n"))
+	if !ok {
+		return "", false
+	}
+
+	lines := []string{"--- expected", "+++ actual"}
+	for start := 0; start < len(ops); {
+		// find the next change
+		for start < len(ops) && ops[start].kind == ' ' {
+			start++
+		}
+		if start == len(ops) {
+			break
+		}
+
+		// extend the hunk until there is a long enough run of unchanged lines
+		end, unchanged := start, 0
+		for end < len(ops) && unchanged <= 2*diffContext {
+			if ops[end].kind == ' ' {
+				unchanged++
+			} else {
+				unchanged = 0
+			}
+			end++
+		}
+		if unchanged > diffContext {
+			end -= unchanged - diffContext
+		}
+
+		from := start - diffContext
+		if from < 0 {
+			from = 0
+		}
+
+		lines = append(lines, hunkHeader(ops[from:end]))
+		for _, op := range ops[from:end] {
+			lines = append(lines, fmt.Sprintf("%c %4s %4s | %s",
+				op.kind, lineNumber(op.expectedLine), lineNumber(op.actualLine), op.text))
+		}
+
+		start = end
+	}
+
+	return "    " + strings.Join(lines, "\n    "), true
+}
+
+func hunkHeader(ops []lineOp) string {
+	expectedStart, expectedCount := 0, 0
+	actualStart, actualCount := 0, 0
+
+	for _, op := range ops {
+		if op.expectedLine > 0 {
+			if expectedStart == 0 {
+				expectedStart = op.expectedLine
+			}
+			expectedCount++
+		}
+		if op.actualLine > 0 {
+			if actualStart == 0 {
+				actualStart = op.actualLine
+			}
+			actualCount++
+		}
+	}
+
+	return fmt.Sprintf("@@ -%d,%d +%d,%d @@", expectedStart, expectedCount, actualStart, actualCount)
+}
+
+func lineNumber(n int) string {
+	if n == 0 {
+		return ""
+	}
+	return fmt.Sprint(n)
+}
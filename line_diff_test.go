@@ -0,0 +1,111 @@
+package assert
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestDiffLines(t *testing.T) {
+	ops, ok := diffLines([]string{"a", "b", "c"}, []string{"a", "x", "c", "d"})
+
+	True(t, ok)
+	Equal(t, []lineOp{
+		{' ', 1, 1, "a"},
+		{'-', 2, 0, "b"},
+		{'+', 0, 2, "x"},
+		{' ', 3, 3, "c"},
+		{'+', 0, 4, "d"},
+	}, ops)
+
+	ops, ok = diffLines([]string{"a", "b", "c", "d"}, []string{"a", "c", "d"})
+	True(t, ok)
+	Equal(t, []lineOp{
+		{' ', 1, 1, "a"},
+		{'-', 2, 0, "b"},
+		{' ', 3, 2, "c"},
+		{' ', 4, 3, "d"},
+	}, ops)
+}
+
+func TestDiffLinesLargeText(t *testing.T) {
+	expected := make([]string, 10000)
+	for i := range expected {
+		expected[i] = fmt.Sprint(i)
+	}
+	actual := append([]string(nil), expected...)
+	actual[5000] = "changed"
+
+	ops, ok := diffLines(expected, actual)
+	True(t, ok)
+	Len(t, ops, 10001)
+	Equal(t, lineOp{'-', 5001, 0, "5000"}, ops[5000])
+
+	for i := range actual {
+		actual[i] = "x" + expected[i]
+	}
+	_, ok = diffLines(expected, actual)
+	False(t, ok, "diffLines should give up when too many lines differ")
+
+	mockT := new(bufferT)
+	Equal(mockT, strings.Join(expected, "\n"), strings.Join(actual, "\n"))
+	Contains(t, mockT.buf.String(), `Not equal: "0\n1\n2\n`)
+	NotContains(t, mockT.buf.String(), "--- expected")
+}
+
+func TestUnifiedDiff(t *testing.T) {
+	expected := "1\n2\n3\n4\n5\n6\n7\n8\n9\n10\n11\n12\n13\n14\n15"
+	actual := "1\n2\nthree\n4\n5\n6\n7\n8\n9\n10\n11\n12\n13\nfourteen\n15"
+
+	diff, ok := unifiedDiff(expected, actual)
+	True(t, ok)
+	Equal(t, strings.Join([]string{
+		"    --- expected",
+		"    +++ actual",
+		"    @@ -1,6 +1,6 @@",
+		"         1    1 | 1",
+		"         2    2 | 2",
+		"    -    3      | 3",
+		"    +         3 | three",
+		"         4    4 | 4",
+		"         5    5 | 5",
+		"         6    6 | 6",
+		"    @@ -11,5 +11,5 @@",
+		"        11   11 | 11",
+		"        12   12 | 12",
+		"        13   13 | 13",
+		"    -   14      | 14",
+		"    +        14 | fourteen",
+		"        15   15 | 15",
+	}, "\n"), diff)
+}
+
+func TestEqualReportsLineDiff(t *testing.T) {
+	mockT := new(bufferT)
+	Equal(mockT, "a\nb\nc", "a\nB\nc")
+	Contains(t, mockT.buf.String(), "-    2      | b")
+	Contains(t, mockT.buf.String(), "+         2 | B")
+
+	mockT = new(bufferT)
+	Equal(mockT, []byte("a\nb"), []byte("a\nc"))
+	Contains(t, mockT.buf.String(), "+         2 | c")
+
+	mockT = new(bufferT)
+	Equal(mockT, "ab", "ac")
+	Contains(t, mockT.buf.String(), `Not equal: "ab" (expected)`)
+}
+
+type lineDiffString string
+
+func TestEqualReportsTypesOfMultilineText(t *testing.T) {
+	mockT := new(bufferT)
+	Equal(mockT, "a\nb", []byte("a\nb"))
+	Contains(t, mockT.buf.String(), `Not equal: "a\nb" (expected)`)
+	Contains(t, mockT.buf.String(), `[]byte{0x61, 0xa, 0x62} (actual)`)
+
+	mockT = new(bufferT)
+	Equal(mockT, "a\nb", lineDiffString("a\nb"))
+	Contains(t, mockT.buf.String(), `Not equal: "a\nb" (expected string)`)
+	Contains(t, mockT.buf.String(), `!= "a\nb" (actual assert.lineDiffString)`)
+	NotContains(t, mockT.buf.String(), "--- expected")
+}
@@ -0,0 +1,358 @@
+package assert
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// Matcher checks a value, describing what it expects and why a value did not
+// match so that failures can explain which part of a check failed. A Matcher
+// can be used as the expected value of Equal, Contains, ElementsMatch and the
+// other assertions that compare values, including inside of maps, slices and
+// structs.
+type Matcher interface {
+	// Match returns whether actual matches.
+	Match(actual interface{}) bool
+
+	// Describe returns a description of the values that match, such as
+	// "having length 3".
+	Describe() string
+
+	// DescribeMismatch returns why actual did not match, such as "had length
+	// 2".
+	DescribeMismatch(actual interface{}) string
+}
+
+var matcherType = reflect.TypeOf((*Matcher)(nil)).Elem()
+
+// matcherOf returns the Matcher held in v, if there is one.
+func matcherOf(v reflect.Value) (Matcher, bool) {
+	if v.Kind() == reflect.Interface && !v.IsNil() {
+		v = v.Elem()
+	}
+	if !v.IsValid() || !v.CanInterface() || !v.Type().Implements(matcherType) {
+		return nil, false
+	}
+
+	return v.Interface().(Matcher), true
+}
+
+// isMatcher returns whether v holds a Matcher.
+func isMatcher(v reflect.Value) bool {
+	_, ok := matcherOf(v)
+	return ok
+}
+
+// toMatcher returns expected if it is a Matcher, otherwise a Matcher for values
+// equal to expected.
+func toMatcher(expected interface{}) Matcher {
+	if m, ok := expected.(Matcher); ok {
+		return m
+	}
+
+	return EqualTo(expected)
+}
+
+// That asserts that actual matches the Matcher.
+//
+//    assert.That(t, events, assert.Each(assert.AllOf(
+//      assert.HasField("Status", "ok"),
+//      assert.HasField("ID", assert.MatchesRegexp("^evt_")),
+//    )))
+//
+// Returns whether the assertion was successful (true) or not (false).
+func That(t TestingT, actual interface{}, matcher Matcher, msgAndArgs ...interface{}) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+
+	if !matcher.Match(actual) {
+		return Fail(t, matcherFailureMessage(matcher, actual), msgAndArgs...)
+	}
+
+	return true
+}
+
+// matcherFailureMessage describes why actual did not match the matcher.
+func matcherFailureMessage(matcher Matcher, actual interface{}) string {
+	return fmt.Sprintf("Expected: %s\n     but: %s", matcher.Describe(), matcher.DescribeMismatch(actual))
+}
+
+type equalToMatcher struct {
+	expected interface{}
+}
+
+// EqualTo matches values equal to expected, as compared by Equal.
+//
+//    assert.That(t, count, assert.AnyOf(assert.EqualTo(1), assert.EqualTo(2)))
+func EqualTo(expected interface{}) Matcher {
+	return equalToMatcher{expected: expected}
+}
+
+func (m equalToMatcher) Match(actual interface{}) bool {
+	return objectsAreEqual(m.expected, actual)
+}
+
+func (m equalToMatcher) Describe() string {
+	return fmt.Sprintf("equal to %#v", m.expected)
+}
+
+func (m equalToMatcher) DescribeMismatch(actual interface{}) string {
+	return fmt.Sprintf("was %#v", actual)
+}
+
+type regexpMatcher struct {
+	rx interface{}
+}
+
+// MatchesRegexp matches values that the regexp matches, after formatting them
+// with %v. The regexp can be a string or *regexp.Regexp, as for Regexp.
+//
+//    assert.That(t, id, assert.MatchesRegexp("^evt_"))
+func MatchesRegexp(rx interface{}) Matcher {
+	return regexpMatcher{rx: rx}
+}
+
+func (m regexpMatcher) Match(actual interface{}) bool {
+	return matchRegexp(m.rx, actual)
+}
+
+func (m regexpMatcher) Describe() string {
+	return fmt.Sprintf("matching regexp %q", fmt.Sprint(m.rx))
+}
+
+func (m regexpMatcher) DescribeMismatch(actual interface{}) string {
+	return fmt.Sprintf("was %#v", actual)
+}
+
+type lenMatcher struct {
+	length int
+}
+
+// HasLen matches values with the given length, such as strings, slices, maps
+// and channels.
+//
+//    assert.That(t, users, assert.HasLen(3))
+func HasLen(length int) Matcher {
+	return lenMatcher{length: length}
+}
+
+func (m lenMatcher) Match(actual interface{}) bool {
+	ok, l := getLen(actual)
+	return ok && l == m.length
+}
+
+func (m lenMatcher) Describe() string {
+	return fmt.Sprintf("having length %d", m.length)
+}
+
+func (m lenMatcher) DescribeMismatch(actual interface{}) string {
+	ok, l := getLen(actual)
+	if !ok {
+		return fmt.Sprintf("was %#v, which does not have a length", actual)
+	}
+
+	return fmt.Sprintf("had length %d", l)
+}
+
+type fieldMatcher struct {
+	name    string
+	matcher Matcher
+}
+
+// HasField matches structs, or pointers to structs, with an exported field
+// called name whose value matches expected. If expected is not a Matcher the
+// field must be equal to it.
+//
+//    assert.That(t, user, assert.HasField("Name", "John"))
+//    assert.That(t, user, assert.HasField("Email", assert.MatchesRegexp("@example.com$")))
+func HasField(name string, expected interface{}) Matcher {
+	return fieldMatcher{name: name, matcher: toMatcher(expected)}
+}
+
+func (m fieldMatcher) field(actual interface{}) (interface{}, bool) {
+	v := reflect.ValueOf(actual)
+	for v.Kind() == reflect.Ptr && !v.IsNil() {
+		v = v.Elem()
+	}
+	if v.Kind() != reflect.Struct {
+		return nil, false
+	}
+
+	field := v.FieldByName(m.name)
+	if !field.IsValid() || !field.CanInterface() {
+		return nil, false
+	}
+
+	return field.Interface(), true
+}
+
+func (m fieldMatcher) Match(actual interface{}) bool {
+	field, ok := m.field(actual)
+	return ok && m.matcher.Match(field)
+}
+
+func (m fieldMatcher) Describe() string {
+	return fmt.Sprintf("having field %s %s", m.name, m.matcher.Describe())
+}
+
+func (m fieldMatcher) DescribeMismatch(actual interface{}) string {
+	field, ok := m.field(actual)
+	if !ok {
+		return fmt.Sprintf("was %#v, which does not have an exported field %s", actual, m.name)
+	}
+
+	return fmt.Sprintf("field %s %s", m.name, m.matcher.DescribeMismatch(field))
+}
+
+type eachMatcher struct {
+	matcher Matcher
+}
+
+// Each matches arrays and slices where every element matches expected. If
+// expected is not a Matcher every element must be equal to it.
+//
+//    assert.That(t, statuses, assert.Each("ok"))
+func Each(expected interface{}) Matcher {
+	return eachMatcher{matcher: toMatcher(expected)}
+}
+
+// firstMismatch returns the index of the first element that does not match, or
+// -1 if they all match.
+func (m eachMatcher) firstMismatch(list reflect.Value) int {
+	for i := 0; i < list.Len(); i++ {
+		if !m.matcher.Match(list.Index(i).Interface()) {
+			return i
+		}
+	}
+
+	return -1
+}
+
+func (m eachMatcher) Match(actual interface{}) bool {
+	list := reflect.ValueOf(actual)
+	return isList(list) && m.firstMismatch(list) == -1
+}
+
+func (m eachMatcher) Describe() string {
+	return "every element " + m.matcher.Describe()
+}
+
+func (m eachMatcher) DescribeMismatch(actual interface{}) string {
+	list := reflect.ValueOf(actual)
+	if !isList(list) {
+		return fmt.Sprintf("was %#v, which is not an array or slice", actual)
+	}
+
+	i := m.firstMismatch(list)
+	return fmt.Sprintf("element [%d] %s", i, m.matcher.DescribeMismatch(list.Index(i).Interface()))
+}
+
+type allOfMatcher struct {
+	matchers []Matcher
+}
+
+// AllOf matches values that match all of the matchers.
+//
+//    assert.That(t, name, assert.AllOf(assert.HasLen(4), assert.MatchesRegexp("^J")))
+func AllOf(matchers ...Matcher) Matcher {
+	return allOfMatcher{matchers: matchers}
+}
+
+func (m allOfMatcher) Match(actual interface{}) bool {
+	for _, matcher := range m.matchers {
+		if !matcher.Match(actual) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (m allOfMatcher) Describe() string {
+	return "all of (" + describeAll(m.matchers) + ")"
+}
+
+func (m allOfMatcher) DescribeMismatch(actual interface{}) string {
+	var mismatches []string
+	for _, matcher := range m.matchers {
+		if !matcher.Match(actual) {
+			mismatches = append(mismatches, describeMismatch(matcher, actual))
+		}
+	}
+
+	return strings.Join(mismatches, "; ")
+}
+
+type anyOfMatcher struct {
+	matchers []Matcher
+}
+
+// AnyOf matches values that match at least one of the matchers.
+//
+//    assert.That(t, status, assert.AnyOf(assert.EqualTo("ok"), assert.EqualTo("pending")))
+func AnyOf(matchers ...Matcher) Matcher {
+	return anyOfMatcher{matchers: matchers}
+}
+
+func (m anyOfMatcher) Match(actual interface{}) bool {
+	for _, matcher := range m.matchers {
+		if matcher.Match(actual) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (m anyOfMatcher) Describe() string {
+	return "any of (" + describeAll(m.matchers) + ")"
+}
+
+func (m anyOfMatcher) DescribeMismatch(actual interface{}) string {
+	mismatches := make([]string, len(m.matchers))
+	for i, matcher := range m.matchers {
+		mismatches[i] = describeMismatch(matcher, actual)
+	}
+
+	return strings.Join(mismatches, "; ")
+}
+
+type notMatcher struct {
+	matcher Matcher
+}
+
+// Not matches values that do not match the matcher.
+//
+//    assert.That(t, users, assert.Not(assert.HasLen(0)))
+func Not(matcher Matcher) Matcher {
+	return notMatcher{matcher: matcher}
+}
+
+func (m notMatcher) Match(actual interface{}) bool {
+	return !m.matcher.Match(actual)
+}
+
+func (m notMatcher) Describe() string {
+	return "not " + m.matcher.Describe()
+}
+
+func (m notMatcher) DescribeMismatch(actual interface{}) string {
+	return fmt.Sprintf("was %#v", actual)
+}
+
+// describeAll joins the descriptions of matchers.
+func describeAll(matchers []Matcher) string {
+	descriptions := make([]string, len(matchers))
+	for i, matcher := range matchers {
+		descriptions[i] = matcher.Describe()
+	}
+
+	return strings.Join(descriptions, ", ")
+}
+
+// describeMismatch explains which matcher failed, and why.
+func describeMismatch(matcher Matcher, actual interface{}) string {
+	return fmt.Sprintf("expected %s, but %s", matcher.Describe(), matcher.DescribeMismatch(actual))
+}
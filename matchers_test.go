@@ -0,0 +1,155 @@
+package assert
+
+import (
+	"regexp"
+	"testing"
+)
+
+type matcherEvent struct {
+	ID     string
+	Status string
+	tags   []string
+}
+
+func TestMatchers(t *testing.T) {
+	event := matcherEvent{ID: "evt_1", Status: "ok"}
+
+	cases := []struct {
+		matcher Matcher
+		actual  interface{}
+		result  bool
+	}{
+		{EqualTo(1), 1, true},
+		{EqualTo(1), int64(1), false},
+		{MatchesRegexp("^evt_"), "evt_1", true},
+		{MatchesRegexp(regexp.MustCompile(`\d$`)), "evt_a", false},
+		{HasLen(2), []int{1, 2}, true},
+		{HasLen(2), "abc", false},
+		{HasLen(2), 12, false},
+		{HasField("Status", "ok"), event, true},
+		{HasField("Status", "ok"), &event, true},
+		{HasField("ID", MatchesRegexp("^evt_")), event, true},
+		{HasField("Status", "bad"), event, false},
+		{HasField("Missing", "ok"), event, false},
+		{HasField("tags", nil), event, false},
+		{HasField("Status", "ok"), "ok", false},
+		{Each(HasLen(1)), []string{"a", "b"}, true},
+		{Each(1), [2]int{1, 1}, true},
+		{Each(1), []int{}, true},
+		{Each(1), []int{1, 2}, false},
+		{Each(1), 1, false},
+		{AllOf(HasLen(2), Each("a")), []string{"a", "a"}, true},
+		{AllOf(HasLen(2), Each("a")), []string{"a", "b"}, false},
+		{AllOf(), 1, true},
+		{AnyOf(EqualTo(1), EqualTo(2)), 2, true},
+		{AnyOf(EqualTo(1), EqualTo(2)), 3, false},
+		{AnyOf(), 1, false},
+		{Not(HasLen(0)), []int{1}, true},
+		{Not(HasLen(0)), []int{}, false},
+	}
+
+	for _, c := range cases {
+		if c.matcher.Match(c.actual) != c.result {
+			t.Errorf("%s: Match(%#v) should return %v", c.matcher.Describe(), c.actual, c.result)
+		}
+	}
+}
+
+func TestMatcherDescriptions(t *testing.T) {
+	cases := []struct {
+		matcher            Matcher
+		actual             interface{}
+		describe, mismatch string
+	}{
+		{EqualTo("ok"), "bad",
+			`equal to "ok"`, `was "bad"`},
+		{MatchesRegexp("^evt_"), "abc",
+			`matching regexp "^evt_"`, `was "abc"`},
+		{HasLen(3), []int{1},
+			"having length 3", "had length 1"},
+		{HasLen(3), 1,
+			"having length 3", "was 1, which does not have a length"},
+		{HasField("Status", "ok"), matcherEvent{Status: "bad"},
+			`having field Status equal to "ok"`, `field Status was "bad"`},
+		{HasField("Status", "ok"), 1,
+			`having field Status equal to "ok"`, "was 1, which does not have an exported field Status"},
+		{Each(HasLen(1)), []string{"a", "bc"},
+			"every element having length 1", "element [1] had length 2"},
+		{Each(1), "a",
+			"every element equal to 1", `was "a", which is not an array or slice`},
+		{AllOf(HasLen(1), EqualTo("a")), "b",
+			`all of (having length 1, equal to "a")`, `expected equal to "a", but was "b"`},
+		{AnyOf(HasLen(2), EqualTo("a")), "b",
+			`any of (having length 2, equal to "a")`, `expected having length 2, but had length 1; expected equal to "a", but was "b"`},
+		{Not(EqualTo(1)), 1,
+			"not equal to 1", "was 1"},
+	}
+
+	for _, c := range cases {
+		Equal(t, c.describe, c.matcher.Describe())
+		Equal(t, c.mismatch, c.matcher.DescribeMismatch(c.actual))
+	}
+}
+
+func TestThat(t *testing.T) {
+	mockT := new(testing.T)
+	events := []matcherEvent{
+		{ID: "evt_1", Status: "ok"},
+		{ID: "evt_2", Status: "failed"},
+	}
+	matcher := Each(AllOf(
+		HasField("Status", "ok"),
+		HasField("ID", MatchesRegexp("^evt_")),
+	))
+
+	if !That(mockT, events[:1], matcher) {
+		t.Error("That should return true")
+	}
+	if That(mockT, events, matcher) {
+		t.Error("That should return false")
+	}
+
+	bufT := new(helperT)
+	That(bufT, events, matcher)
+	Contains(t, bufT.buf.String(), `Expected: every element all of (having field Status equal to "ok", having field ID matching regexp "^evt_")
+	     but: element [1] expected having field Status equal to "ok", but field Status was "failed"`)
+}
+
+func TestMatchersInOtherAssertions(t *testing.T) {
+	mockT := new(testing.T)
+
+	if !Equal(mockT, map[string]interface{}{"id": MatchesRegexp("^evt_"), "n": 1}, map[string]interface{}{"id": "evt_1", "n": 1}) {
+		t.Error("Equal should use a Matcher inside a map")
+	}
+	if !Contains(mockT, []matcherEvent{{Status: "ok"}}, HasField("Status", "ok")) {
+		t.Error("Contains should use a Matcher as the element")
+	}
+	if !ElementsMatch(mockT, []interface{}{HasLen(2), EqualTo("a")}, []string{"a", "bc"}) {
+		t.Error("ElementsMatch should use Matchers as elements")
+	}
+	if !Equal(mockT, Not(EqualTo(nil)), 1) || Equal(mockT, Not(EqualTo(nil)), nil) {
+		t.Error("Equal should use a Matcher as the expected value")
+	}
+	if !Equal(mockT, HasLen(3), HasLen(3)) || Equal(mockT, HasLen(3), HasLen(2)) {
+		t.Error("Equal should compare two Matchers as values")
+	}
+	if !ElementsMatch(mockT, []Matcher{HasLen(3), EqualTo("a")}, []Matcher{EqualTo("a"), HasLen(3)}) {
+		t.Error("ElementsMatch should compare two lists of Matchers as values")
+	}
+	if Equal(mockT, []Matcher{HasLen(3)}, []Matcher{EqualTo("a")}) {
+		t.Error("Equal should not match a Matcher against another Matcher")
+	}
+
+	bufT := new(bufferT)
+	Equal(bufT, map[string]interface{}{"id": MatchesRegexp("^evt_")}, map[string]interface{}{"id": "abc"})
+	Contains(t, bufT.buf.String(), `["id"]: expected matching regexp "^evt_", but was "abc"`)
+
+	helpT := new(helperT)
+	Equal(helpT, HasLen(3), []int{1})
+	Contains(t, helpT.buf.String(), "Error:\t\tExpected: having length 3\n\t     but: had length 1\n")
+	NotContains(t, helpT.buf.String(), "lenMatcher")
+
+	helpT = new(helperT)
+	Equal(helpT, HasLen(3), HasLen(2))
+	NotContains(t, helpT.buf.String(), "Expected: having length 3")
+}
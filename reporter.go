@@ -0,0 +1,161 @@
+package assert
+
+import (
+	"fmt"
+	"strings"
+)
+
+// note is an extra line shown with a failure, such as the path navigated to
+// reach the value that was checked.
+type note struct {
+	label, value string
+}
+
+// reporter wraps a TestingT to add notes and context labels to the failures
+// reported through it, or to discard them. When failures are being discarded,
+// misused records that an assertion was reported anyway by failMisuse.
+type reporter struct {
+	TestingT
+	notes    []note
+	contexts []string
+	discard  bool
+	misused  bool
+}
+
+func (r *reporter) Errorf(format string, args ...interface{}) {
+	if !r.discard {
+		r.TestingT.Errorf(format, args...)
+	}
+}
+
+// setNote adds a note, replacing any existing note with the same label.
+func (r *reporter) setNote(label, value string) {
+	for i := range r.notes {
+		if r.notes[i].label == label {
+			r.notes[i].value = value
+			return
+		}
+	}
+
+	r.notes = append(r.notes, note{label: label, value: value})
+}
+
+// helperReporter is the reporter used when the wrapped TestingT has a Helper
+// method. The method is promoted, rather than called by a method of reporter,
+// so that it marks the function that called it as a helper.
+type helperReporter struct {
+	*reporter
+	tHelper
+}
+
+func (r helperReporter) Errorf(format string, args ...interface{}) {
+	r.Helper()
+
+	if !r.discard {
+		r.TestingT.Errorf(format, args...)
+	}
+}
+
+// failNowReporter and helperFailNowReporter are the reporters used when the
+// wrapped TestingT has a FailNow method, so that the require package can still
+// stop the test.
+type failNowReporter struct {
+	*reporter
+	failNower
+}
+
+type helperFailNowReporter struct {
+	helperReporter
+	failNower
+}
+
+// reporterOf returns the reporter wrapping t, if there is one.
+func reporterOf(t TestingT) (*reporter, bool) {
+	switch r := t.(type) {
+	case *reporter:
+		return r, true
+	case helperReporter:
+		return r.reporter, true
+	case failNowReporter:
+		return r.reporter, true
+	case helperFailNowReporter:
+		return r.reporter, true
+	}
+
+	return nil, false
+}
+
+// withReporter returns a copy of t, wrapped in a reporter if it isn't already,
+// that has been changed by f.
+func withReporter(t TestingT, f func(r *reporter)) TestingT {
+	r := &reporter{TestingT: t}
+	if existing, ok := reporterOf(t); ok {
+		r.TestingT = existing.TestingT
+		r.notes = append([]note(nil), existing.notes...)
+		r.contexts = append([]string(nil), existing.contexts...)
+		r.discard = existing.discard
+	}
+	f(r)
+
+	h, hasHelper := r.TestingT.(tHelper)
+	fn, hasFailNow := r.TestingT.(failNower)
+
+	switch {
+	case hasHelper && hasFailNow:
+		return helperFailNowReporter{helperReporter: helperReporter{reporter: r, tHelper: h}, failNower: fn}
+	case hasHelper:
+		return helperReporter{reporter: r, tHelper: h}
+	case hasFailNow:
+		return failNowReporter{reporter: r, failNower: fn}
+	}
+
+	return r
+}
+
+// formatNotes returns the notes added to t as lines of a failure, each starting
+// with prefix.
+func formatNotes(t TestingT, prefix string) string {
+	r, ok := reporterOf(t)
+	if !ok {
+		return ""
+	}
+
+	var lines strings.Builder
+	for _, n := range r.notes {
+		lines.WriteString(prefix + n.label + ":\t")
+		if len(n.label) < 7 {
+			lines.WriteString("\t")
+		}
+		lines.WriteString(n.value)
+	}
+
+	return lines.String()
+}
+
+// WithContext returns a TestingT that reports to t, but includes the label given
+// by format and args in the messages of its failures, after any labels already
+// added. If t has Helper or FailNow methods so does the TestingT returned.
+//
+//    assert.Equal(assert.WithContext(t, "case %d", i), tc.expected, actual)
+func WithContext(t TestingT, format string, args ...interface{}) TestingT {
+	label := fmt.Sprintf(format, args...)
+
+	return withReporter(t, func(r *reporter) {
+		r.contexts = append(r.contexts, label)
+	})
+}
+
+// contextMessage returns message prefixed by the context labels added to t.
+func contextMessage(t TestingT, message string) string {
+	r, ok := reporterOf(t)
+	if !ok || len(r.contexts) == 0 {
+		return message
+	}
+
+	label := strings.Join(r.contexts, ": ")
+	if message == "" {
+		return label
+	}
+
+	return label + ": " + message
+}
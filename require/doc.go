@@ -0,0 +1,31 @@
+/*
+Package require implements the same assertions as the assert package, but
+stops the test with FailNow when an assertion fails, instead of letting it
+continue.
+
+Example Usage
+
+   import (
+     "testing"
+     "hawx.me/code/assert/require"
+   )
+
+   func TestSomething(t *testing.T) {
+     config, err := LoadConfig()
+     require.Nil(t, err)
+
+     require.Equal(t, "Hello", config.Greeting)
+   }
+
+if you require many times, use the below:
+
+   func TestSomething(t *testing.T) {
+     require := require.New(t)
+
+     config, err := LoadConfig()
+     require.Nil(err)
+
+     require.Equal("Hello", config.Greeting)
+   }
+*/
+package require
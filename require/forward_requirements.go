@@ -0,0 +1,779 @@
+package require
+
+import (
+	"time"
+
+	"hawx.me/code/assert"
+)
+
+// Assertions provides assertion methods around the TestingT interface, that
+// stop the test with FailNow if they fail.
+type Assertions struct {
+	t TestingT
+}
+
+// New makes a new Assertions object for the specified TestingT.
+func New(t TestingT) *Assertions {
+	return &Assertions{
+		t: t,
+	}
+}
+
+// WithContext returns Assertions that report to the same TestingT, but include
+// the label given by format and args in the messages of their failures. Labels
+// are nested when WithContext is called again on the result.
+//
+//    for i, tc := range cases {
+//      require := require.New(t).WithContext("case %d: %s", i, tc.name)
+//      require.Equal(tc.expected, Parse(tc.input))
+//    }
+func (a *Assertions) WithContext(format string, args ...interface{}) *Assertions {
+	return &Assertions{
+		t: assert.WithContext(a.t, format, args...).(TestingT),
+	}
+}
+
+// Fail reports a failure with failureMessage, then stops the test.
+func (a *Assertions) Fail(failureMessage string, msgAndArgs ...interface{}) {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	Fail(a.t, failureMessage, msgAndArgs...)
+}
+
+// Implements asserts that an object is implemented by the specified interface.
+//
+//    require.Implements((*MyInterface)(nil), new(MyObject), "MyObject")
+func (a *Assertions) Implements(interfaceObject interface{}, object interface{}, msgAndArgs ...interface{}) {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	Implements(a.t, interfaceObject, object, msgAndArgs...)
+}
+
+// IsType asserts that the specified objects are of the same type.
+func (a *Assertions) IsType(expectedType interface{}, object interface{}, msgAndArgs ...interface{}) {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	IsType(a.t, expectedType, object, msgAndArgs...)
+}
+
+// Equal asserts that two objects are equal.
+//
+//    require.Equal(123, 123, "123 and 123 should be equal")
+func (a *Assertions) Equal(expected, actual interface{}, msgAndArgs ...interface{}) {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	Equal(a.t, expected, actual, msgAndArgs...)
+}
+
+// Equivalent asserts that two objects are equal or convertable to the same types
+// and equal.
+//
+//    require.Equivalent(uint32(123), int32(123), "123 and 123 should be equal")
+func (a *Assertions) Equivalent(expected, actual interface{}, msgAndArgs ...interface{}) {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	Equivalent(a.t, expected, actual, msgAndArgs...)
+}
+
+// Exactly asserts that two objects are equal is value and type.
+//
+//    require.Exactly(int32(123), int64(123), "123 and 123 should NOT be equal")
+func (a *Assertions) Exactly(expected, actual interface{}, msgAndArgs ...interface{}) {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	Exactly(a.t, expected, actual, msgAndArgs...)
+}
+
+// EqualWith asserts that two objects are equal, using the EqualOption values in
+// msgAndArgs to control how they are compared. The other values in msgAndArgs
+// are used as the message, as for any other assertion.
+//
+//    require.EqualWith(expected, actual, assert.IgnoreFields("CreatedAt"), assert.EquateEmpty())
+func (a *Assertions) EqualWith(expected, actual interface{}, msgAndArgs ...interface{}) {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	EqualWith(a.t, expected, actual, msgAndArgs...)
+}
+
+// NotNil asserts that the specified object is not nil.
+//
+//    require.NotNil(err, "err should be something")
+func (a *Assertions) NotNil(object interface{}, msgAndArgs ...interface{}) {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	NotNil(a.t, object, msgAndArgs...)
+}
+
+// Nil asserts that the specified object is nil.
+//
+//    require.Nil(err, "err should be nothing")
+func (a *Assertions) Nil(object interface{}, msgAndArgs ...interface{}) {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	Nil(a.t, object, msgAndArgs...)
+}
+
+// Error asserts that a function returned an error (i.e. not nil).
+//
+//    actualObj, err := SomeFunction()
+//    require.Error(err, "An error was expected")
+func (a *Assertions) Error(err error, msgAndArgs ...interface{}) {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	Error(a.t, err, msgAndArgs...)
+}
+
+// NoError asserts that a function returned no error (i.e. nil).
+//
+//    actualObj, err := SomeFunction()
+//    require.NoError(err)
+//    require.Equal(expectedObj, actualObj)
+func (a *Assertions) NoError(err error, msgAndArgs ...interface{}) {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	NoError(a.t, err, msgAndArgs...)
+}
+
+// ErrorIs asserts that at least one of the errors in err's chain matches
+// target, using errors.Is.
+//
+//    require.ErrorIs(err, fs.ErrNotExist)
+func (a *Assertions) ErrorIs(err, target error, msgAndArgs ...interface{}) {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	ErrorIs(a.t, err, target, msgAndArgs...)
+}
+
+// NotErrorIs asserts that none of the errors in err's chain match target,
+// using errors.Is.
+//
+//    require.NotErrorIs(err, fs.ErrNotExist)
+func (a *Assertions) NotErrorIs(err, target error, msgAndArgs ...interface{}) {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	NotErrorIs(a.t, err, target, msgAndArgs...)
+}
+
+// ErrorAs asserts that at least one of the errors in err's chain matches
+// target, using errors.As, and if so sets target to that error.
+//
+//    var pathErr *fs.PathError
+//    require.ErrorAs(err, &pathErr)
+func (a *Assertions) ErrorAs(err error, target interface{}, msgAndArgs ...interface{}) {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	ErrorAs(a.t, err, target, msgAndArgs...)
+}
+
+// EqualError asserts that a function returned an error (i.e. not nil) and that
+// it is equal to the provided error message.
+//
+//    actualObj, err := SomeFunction()
+//    require.EqualError(err, expectedErrorString, "An error was expected")
+func (a *Assertions) EqualError(theError error, errString string, msgAndArgs ...interface{}) {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	EqualError(a.t, theError, errString, msgAndArgs...)
+}
+
+// ErrorContains asserts that a function returned an error (i.e. not nil) and
+// that its message contains the specified substring.
+//
+//    actualObj, err := SomeFunction()
+//    require.ErrorContains(err, "not found")
+func (a *Assertions) ErrorContains(theError error, contains string, msgAndArgs ...interface{}) {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	ErrorContains(a.t, theError, contains, msgAndArgs...)
+}
+
+// Empty asserts that the specified object is empty.  I.e. nil, "", false, 0 or either
+// a slice or a channel with len == 0.
+//
+// require.Empty(obj)
+func (a *Assertions) Empty(object interface{}, msgAndArgs ...interface{}) {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	Empty(a.t, object, msgAndArgs...)
+}
+
+// NotEmpty asserts that the specified object is NOT empty.  I.e. not nil, "", false, 0 or either
+// a slice or a channel with len == 0.
+//
+// if require.NotEmpty(obj) {
+//   assert.Equal(t, "two", obj[1])
+// }
+func (a *Assertions) NotEmpty(object interface{}, msgAndArgs ...interface{}) {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	NotEmpty(a.t, object, msgAndArgs...)
+}
+
+// Len asserts that the specified object has specific length.
+// Len also fails if the object has a type that len() not accept.
+//
+//    require.Len(mySlice, 3, "The size of slice is not 3")
+func (a *Assertions) Len(object interface{}, length int, msgAndArgs ...interface{}) {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	Len(a.t, object, length, msgAndArgs...)
+}
+
+// True asserts that the specified value is true.
+//
+//    require.True(myBool, "myBool should be true")
+func (a *Assertions) True(value bool, msgAndArgs ...interface{}) {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	True(a.t, value, msgAndArgs...)
+}
+
+// False asserts that the specified value is true.
+//
+//    require.False(myBool, "myBool should be false")
+func (a *Assertions) False(value bool, msgAndArgs ...interface{}) {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	False(a.t, value, msgAndArgs...)
+}
+
+// NotEqual asserts that the specified values are NOT equal.
+//
+//    require.NotEqual(obj1, obj2, "two objects shouldn't be equal")
+func (a *Assertions) NotEqual(expected, actual interface{}, msgAndArgs ...interface{}) {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	NotEqual(a.t, expected, actual, msgAndArgs...)
+}
+
+// Contains asserts that the specified string or list(array, slice...) contains the
+// specified substring or element.
+//
+//    require.Contains("Hello World", "World", "But 'Hello World' does contain 'World'")
+//    require.Contains(["Hello", "World"], "World", "But ["Hello", "World"] does contain 'World'")
+func (a *Assertions) Contains(s, contains interface{}, msgAndArgs ...interface{}) {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	Contains(a.t, s, contains, msgAndArgs...)
+}
+
+// NotContains asserts that the specified string or list(array, slice...) does NOT contain the
+// specified substring or element.
+//
+//    require.NotContains("Hello World", "Earth", "But 'Hello World' does NOT contain 'Earth'")
+//    require.NotContains(["Hello", "World"], "Earth", "But ['Hello', 'World'] does NOT contain 'Earth'")
+func (a *Assertions) NotContains(s, contains interface{}, msgAndArgs ...interface{}) {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	NotContains(a.t, s, contains, msgAndArgs...)
+}
+
+// ElementsMatch asserts that the specified lists (arrays or slices) contain the
+// same elements, ignoring their order. Duplicates must appear the same number of
+// times in each list.
+//
+//    require.ElementsMatch([]int{1, 3, 2, 3}, []int{3, 3, 1, 2})
+func (a *Assertions) ElementsMatch(expected, actual interface{}, msgAndArgs ...interface{}) {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	ElementsMatch(a.t, expected, actual, msgAndArgs...)
+}
+
+// Subset asserts that the specified list (array or slice) contains all of the
+// elements of subset, or that the specified map contains all of the keys of
+// subset with the same values.
+//
+//    require.Subset([]int{1, 2, 3}, []int{1, 3})
+//    require.Subset(map[string]int{"a": 1, "b": 2}, map[string]int{"a": 1})
+func (a *Assertions) Subset(list, subset interface{}, msgAndArgs ...interface{}) {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	Subset(a.t, list, subset, msgAndArgs...)
+}
+
+// NotSubset asserts that the specified list (array or slice) does NOT contain
+// all of the elements of subset, or that the specified map does NOT contain all
+// of the entries of subset.
+//
+//    require.NotSubset([]int{1, 2, 3}, []int{1, 4})
+//    require.NotSubset(map[string]int{"a": 1, "b": 2}, map[string]int{"a": 2})
+func (a *Assertions) NotSubset(list, subset interface{}, msgAndArgs ...interface{}) {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	NotSubset(a.t, list, subset, msgAndArgs...)
+}
+
+// HasKey asserts that the specified map contains the key.
+//
+//    require.HasKey(map[string]int{"a": 1}, "a")
+func (a *Assertions) HasKey(m, key interface{}, msgAndArgs ...interface{}) {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	HasKey(a.t, m, key, msgAndArgs...)
+}
+
+// NotHasKey asserts that the specified map does NOT contain the key.
+//
+//    require.NotHasKey(map[string]int{"a": 1}, "b")
+func (a *Assertions) NotHasKey(m, key interface{}, msgAndArgs ...interface{}) {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	NotHasKey(a.t, m, key, msgAndArgs...)
+}
+
+// HasEntry asserts that the specified map contains the key, and that its value
+// is equal to the expected value.
+//
+//    require.HasEntry(map[string]int{"a": 1}, "a", 1)
+func (a *Assertions) HasEntry(m, key, expected interface{}, msgAndArgs ...interface{}) {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	HasEntry(a.t, m, key, expected, msgAndArgs...)
+}
+
+// Condition uses a Comparison to assert a complex condition.
+func (a *Assertions) Condition(comp assert.Comparison, msgAndArgs ...interface{}) {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	Condition(a.t, comp, msgAndArgs...)
+}
+
+// That asserts that actual matches the Matcher.
+//
+//    require.That(events, assert.Each(assert.AllOf(
+//      assert.HasField("Status", "ok"),
+//      assert.HasField("ID", assert.MatchesRegexp("^evt_")),
+//    )))
+func (a *Assertions) That(actual interface{}, matcher assert.Matcher, msgAndArgs ...interface{}) {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	That(a.t, actual, matcher, msgAndArgs...)
+}
+
+// Eventually asserts that the Comparison returns true within waitFor, calling
+// it every tick.
+//
+//    require.Eventually(func() bool { return cache.Len() == 3 }, time.Second, 10*time.Millisecond)
+func (a *Assertions) Eventually(comp assert.Comparison, waitFor, tick time.Duration, msgAndArgs ...interface{}) {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	Eventually(a.t, comp, waitFor, tick, msgAndArgs...)
+}
+
+// Never asserts that the Comparison does not return true within waitFor,
+// calling it every tick.
+//
+//    require.Never(func() bool { return worker.Crashed() }, time.Second, 10*time.Millisecond)
+func (a *Assertions) Never(comp assert.Comparison, waitFor, tick time.Duration, msgAndArgs ...interface{}) {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	Never(a.t, comp, waitFor, tick, msgAndArgs...)
+}
+
+// Consistently asserts that the Comparison returns true every time it is
+// called, every tick, for the whole of waitFor.
+//
+//    require.Consistently(func() bool { return conn.Open() }, time.Second, 10*time.Millisecond)
+func (a *Assertions) Consistently(comp assert.Comparison, waitFor, tick time.Duration, msgAndArgs ...interface{}) {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	Consistently(a.t, comp, waitFor, tick, msgAndArgs...)
+}
+
+// EventuallyWithT asserts that all of the assertions made in condition pass
+// within waitFor, calling it every tick. Failures from attempts other than the
+// last are discarded.
+//
+//    require.EventuallyWithT(func(c *assert.CollectT) {
+//      assert.Equal(c, 3, cache.Len())
+//      assert.Contains(c, cache.Keys(), "a")
+//    }, time.Second, 10*time.Millisecond)
+func (a *Assertions) EventuallyWithT(condition func(c *assert.CollectT), waitFor, tick time.Duration, msgAndArgs ...interface{}) {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	EventuallyWithT(a.t, condition, waitFor, tick, msgAndArgs...)
+}
+
+// Panics asserts that the code inside the specified func panics.
+//
+//   require.Panics(func(){
+//     GoCrazy()
+//   }, "Calling GoCrazy() should panic")
+func (a *Assertions) Panics(f func(), msgAndArgs ...interface{}) {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	Panics(a.t, f, msgAndArgs...)
+}
+
+// PanicsWithValue asserts that the code inside the specified func panics, and
+// that the recovered panic value equals the expected value.
+//
+//   require.PanicsWithValue("crazy error", func(){
+//     GoCrazy()
+//   }, "Calling GoCrazy() should panic with 'crazy error'")
+func (a *Assertions) PanicsWithValue(expected interface{}, f func(), msgAndArgs ...interface{}) {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	PanicsWithValue(a.t, expected, f, msgAndArgs...)
+}
+
+// PanicsWithError asserts that the code inside the specified func panics with an
+// error. If expected is an error the recovered error must match it using
+// errors.Is, otherwise the message of the recovered error must equal expected.
+//
+//   require.PanicsWithError(ErrCrazy, func(){
+//     GoCrazy()
+//   })
+//   require.PanicsWithError("crazy error", func(){
+//     GoCrazy()
+//   })
+func (a *Assertions) PanicsWithError(expected interface{}, f func(), msgAndArgs ...interface{}) {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	PanicsWithError(a.t, expected, f, msgAndArgs...)
+}
+
+// PanicsMatching asserts that the code inside the specified func panics, and
+// that the recovered panic value matches the regexp. Errors are matched using
+// their message, and all other values are formatted with %v.
+//
+//   require.PanicsMatching("index out of range", func(){
+//     GoCrazy()
+//   })
+func (a *Assertions) PanicsMatching(rx interface{}, f func(), msgAndArgs ...interface{}) {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	PanicsMatching(a.t, rx, f, msgAndArgs...)
+}
+
+// NotPanics asserts that the code inside the specified func does NOT panic.
+//
+//   require.NotPanics(func(){
+//     RemainCalm()
+//   }, "Calling RemainCalm() should NOT panic")
+func (a *Assertions) NotPanics(f func(), msgAndArgs ...interface{}) {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	NotPanics(a.t, f, msgAndArgs...)
+}
+
+// WithinDuration asserts that the two times are within duration delta of each other.
+//
+//   require.WithinDuration(time.Now(), time.Now(), 10*time.Second, "The difference should not be more than 10s")
+func (a *Assertions) WithinDuration(expected, actual time.Time, delta time.Duration, msgAndArgs ...interface{}) {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	WithinDuration(a.t, expected, actual, delta, msgAndArgs...)
+}
+
+// InDelta asserts that the two numerals are within delta of each other.
+//
+// 	 require.InDelta(math.Pi, (22 / 7.0), 0.01)
+func (a *Assertions) InDelta(expected, actual interface{}, delta float64, msgAndArgs ...interface{}) {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	InDelta(a.t, expected, actual, delta, msgAndArgs...)
+}
+
+// InDeltaSlice is the same as InDelta, except it compares two slices.
+func (a *Assertions) InDeltaSlice(expected, actual interface{}, delta float64, msgAndArgs ...interface{}) {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	InDeltaSlice(a.t, expected, actual, delta, msgAndArgs...)
+}
+
+// InEpsilon asserts that expected and actual have a relative error less than epsilon
+func (a *Assertions) InEpsilon(expected, actual interface{}, epsilon float64, msgAndArgs ...interface{}) {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	InEpsilon(a.t, expected, actual, epsilon, msgAndArgs...)
+}
+
+// InEpsilonSlice is the same as InEpsilon, except it compares two slices.
+func (a *Assertions) InEpsilonSlice(expected, actual interface{}, epsilon float64, msgAndArgs ...interface{}) {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	InEpsilonSlice(a.t, expected, actual, epsilon, msgAndArgs...)
+}
+
+// Greater asserts that the first element is greater than the second. Both must
+// be the same type, which can be any number, string, time.Time or big.Int.
+//
+//    require.Greater(2, 1)
+//    require.Greater("b", "a")
+//    require.Greater(time.Now(), start)
+func (a *Assertions) Greater(e1, e2 interface{}, msgAndArgs ...interface{}) {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	Greater(a.t, e1, e2, msgAndArgs...)
+}
+
+// GreaterOrEqual asserts that the first element is greater than or equal to the
+// second.
+//
+//    require.GreaterOrEqual(2, 1)
+//    require.GreaterOrEqual(2, 2)
+func (a *Assertions) GreaterOrEqual(e1, e2 interface{}, msgAndArgs ...interface{}) {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	GreaterOrEqual(a.t, e1, e2, msgAndArgs...)
+}
+
+// Less asserts that the first element is less than the second.
+//
+//    require.Less(1, 2)
+//    require.Less(time.Second, timeout)
+func (a *Assertions) Less(e1, e2 interface{}, msgAndArgs ...interface{}) {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	Less(a.t, e1, e2, msgAndArgs...)
+}
+
+// LessOrEqual asserts that the first element is less than or equal to the
+// second.
+//
+//    require.LessOrEqual(1, 2)
+//    require.LessOrEqual(2, 2)
+func (a *Assertions) LessOrEqual(e1, e2 interface{}, msgAndArgs ...interface{}) {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	LessOrEqual(a.t, e1, e2, msgAndArgs...)
+}
+
+// Positive asserts that the specified number, time.Duration or big.Int is
+// greater than zero.
+//
+//    require.Positive(1)
+//    require.Positive(elapsed)
+func (a *Assertions) Positive(e interface{}, msgAndArgs ...interface{}) {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	Positive(a.t, e, msgAndArgs...)
+}
+
+// Negative asserts that the specified number, time.Duration or big.Int is less
+// than zero.
+//
+//    require.Negative(-1)
+func (a *Assertions) Negative(e interface{}, msgAndArgs ...interface{}) {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	Negative(a.t, e, msgAndArgs...)
+}
+
+// Zero asserts that the specified value is zero. Numbers, time.Duration and
+// big.Int are zero when they equal 0, values with an IsZero() bool method, such
+// as time.Time, when it returns true, and any other value when it is the zero
+// value of its type, such as "" or nil.
+//
+//    require.Zero(balance.Sub(credits))
+func (a *Assertions) Zero(e interface{}, msgAndArgs ...interface{}) {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	Zero(a.t, e, msgAndArgs...)
+}
+
+// NotZero asserts that the specified value is not zero, as described for Zero.
+//
+//    require.NotZero(elapsed)
+func (a *Assertions) NotZero(e interface{}, msgAndArgs ...interface{}) {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	NotZero(a.t, e, msgAndArgs...)
+}
+
+// IsSorted asserts that the specified list is sorted in ascending order, allowing
+// equal elements. The list can be an array or slice of any type accepted by
+// Greater, or a sort.Interface.
+//
+//    require.IsSorted([]string{"a", "b", "b"})
+//    require.IsSorted(sort.IntSlice(ids))
+func (a *Assertions) IsSorted(list interface{}, msgAndArgs ...interface{}) {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	IsSorted(a.t, list, msgAndArgs...)
+}
+
+// IsSortedBy asserts that the specified list is sorted according to less, which
+// must be of the form func(a, b T) bool where T is the type of the elements.
+//
+//    require.IsSortedBy(events, func(a, b Event) bool {
+//      return a.At.Before(b.At)
+//    })
+func (a *Assertions) IsSortedBy(list, less interface{}, msgAndArgs ...interface{}) {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	IsSortedBy(a.t, list, less, msgAndArgs...)
+}
+
+// IsIncreasing asserts that each element of the specified list is greater than
+// the one before it.
+//
+//    require.IsIncreasing([]int{1, 2, 3})
+func (a *Assertions) IsIncreasing(list interface{}, msgAndArgs ...interface{}) {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	IsIncreasing(a.t, list, msgAndArgs...)
+}
+
+// IsNonDecreasing asserts that each element of the specified list is greater
+// than or equal to the one before it.
+//
+//    require.IsNonDecreasing([]int{1, 1, 2})
+func (a *Assertions) IsNonDecreasing(list interface{}, msgAndArgs ...interface{}) {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	IsNonDecreasing(a.t, list, msgAndArgs...)
+}
+
+// IsDecreasing asserts that each element of the specified list is less than the
+// one before it.
+//
+//    require.IsDecreasing([]int{3, 2, 1})
+func (a *Assertions) IsDecreasing(list interface{}, msgAndArgs ...interface{}) {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	IsDecreasing(a.t, list, msgAndArgs...)
+}
+
+// Regexp asserts that a specified regexp matches a string.
+//
+//  require.Regexp(regexp.MustCompile("start"), "it's starting")
+//  require.Regexp("start...$", "it's not starting")
+func (a *Assertions) Regexp(rx interface{}, str interface{}, msgAndArgs ...interface{}) {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	Regexp(a.t, rx, str, msgAndArgs...)
+}
+
+// NotRegexp asserts that a specified regexp does not match a string.
+//
+//  require.NotRegexp(regexp.MustCompile("starts"), "it's starting")
+//  require.NotRegexp("^start", "it's not starting")
+func (a *Assertions) NotRegexp(rx interface{}, str interface{}, msgAndArgs ...interface{}) {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	NotRegexp(a.t, rx, str, msgAndArgs...)
+}
@@ -0,0 +1,67 @@
+package require
+
+import (
+	"testing"
+
+	"hawx.me/code/assert"
+)
+
+func TestForwardRequirementsPass(t *testing.T) {
+	mockT := new(MockT)
+	require := New(mockT)
+
+	require.Equal(1, 1)
+	require.EqualWith([]int{}, []int(nil), assert.EquateEmpty())
+	require.Nil(nil)
+	require.Contains([]int{1, 2}, 2)
+	require.InDeltaSlice([]float64{1.0}, []float64{1.1}, 0.2)
+
+	if mockT.Failed {
+		t.Errorf("Passing requirements should not call FailNow:\n%s", mockT.buf.String())
+	}
+}
+
+func TestForwardRequirementsFail(t *testing.T) {
+	cases := map[string]func(require *Assertions){
+		"Fail":         func(require *Assertions) { require.Fail("failed") },
+		"Equal":        func(require *Assertions) { require.Equal(1, 2) },
+		"EqualWith":    func(require *Assertions) { require.EqualWith([]int{}, []int(nil)) },
+		"Nil":          func(require *Assertions) { require.Nil(1) },
+		"Contains":     func(require *Assertions) { require.Contains([]int{1, 2}, 3) },
+		"InDeltaSlice": func(require *Assertions) { require.InDeltaSlice([]float64{1.0}, []float64{2.0}, 0.5) },
+	}
+
+	for name, f := range cases {
+		mockT := new(MockT)
+		f(New(mockT))
+
+		if !mockT.Failed {
+			t.Errorf("%s should call FailNow", name)
+		}
+	}
+}
+
+func TestForwardRequirementsErrorTrace(t *testing.T) {
+	mockT := new(MockT)
+	New(mockT).Equal(1, 2)
+
+	assert.Contains(t, mockT.buf.String(), "Error Trace:\tforward_requirements_test.go:")
+	assert.NotContains(t, mockT.buf.String(), "forward_requirements.go:")
+}
+
+func TestForwardRequirementsWithContext(t *testing.T) {
+	mockT := new(MockT)
+	require := New(mockT).WithContext("case %d", 1)
+
+	require.Equal(1, 1)
+	if mockT.Failed {
+		t.Error("Passing requirements should not call FailNow")
+	}
+
+	require.WithContext("step %s", "login").Equal(1, 2, "status")
+	if !mockT.Failed {
+		t.Error("Equal should call FailNow")
+	}
+	assert.Contains(t, mockT.buf.String(), "Messages:\tcase 1: step login: status\n")
+	assert.Contains(t, mockT.buf.String(), "Error Trace:\tforward_requirements_test.go:")
+}
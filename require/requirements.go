@@ -0,0 +1,890 @@
+package require
+
+import (
+	"reflect"
+	"time"
+
+	"hawx.me/code/assert"
+)
+
+// TestingT is an interface wrapper around *testing.T
+type TestingT interface {
+	Errorf(format string, args ...interface{})
+	FailNow()
+}
+
+// tHelper is implemented by TestingT values, like *testing.T, that can mark the
+// calling function as a test helper.
+type tHelper interface {
+	Helper()
+}
+
+func init() {
+	assert.HidePackage(reflect.TypeOf(Assertions{}).PkgPath())
+}
+
+// Fail reports a failure with failureMessage, then stops the test.
+func Fail(t TestingT, failureMessage string, msgAndArgs ...interface{}) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+
+	assert.Fail(t, failureMessage, msgAndArgs...)
+	t.FailNow()
+}
+
+// Implements asserts that an object is implemented by the specified interface.
+//
+//    require.Implements(t, (*MyInterface)(nil), new(MyObject), "MyObject")
+func Implements(t TestingT, interfaceObject interface{}, object interface{}, msgAndArgs ...interface{}) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+
+	if !assert.Implements(t, interfaceObject, object, msgAndArgs...) {
+		t.FailNow()
+	}
+}
+
+// IsType asserts that the specified objects are of the same type.
+func IsType(t TestingT, expectedType interface{}, object interface{}, msgAndArgs ...interface{}) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+
+	if !assert.IsType(t, expectedType, object, msgAndArgs...) {
+		t.FailNow()
+	}
+}
+
+// Equal asserts that two objects are equal.
+//
+//    require.Equal(t, 123, 123, "123 and 123 should be equal")
+func Equal(t TestingT, expected, actual interface{}, msgAndArgs ...interface{}) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+
+	if !assert.Equal(t, expected, actual, msgAndArgs...) {
+		t.FailNow()
+	}
+}
+
+// Equivalent asserts that two objects are equal or convertable to the same types
+// and equal.
+//
+//    require.Equivalent(t, uint32(123), int32(123), "123 and 123 should be equal")
+func Equivalent(t TestingT, expected, actual interface{}, msgAndArgs ...interface{}) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+
+	if !assert.Equivalent(t, expected, actual, msgAndArgs...) {
+		t.FailNow()
+	}
+}
+
+// Exactly asserts that two objects are equal is value and type.
+//
+//    require.Exactly(t, int32(123), int64(123), "123 and 123 should NOT be equal")
+func Exactly(t TestingT, expected, actual interface{}, msgAndArgs ...interface{}) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+
+	if !assert.Exactly(t, expected, actual, msgAndArgs...) {
+		t.FailNow()
+	}
+}
+
+// EqualWith asserts that two objects are equal, using the EqualOption values in
+// msgAndArgs to control how they are compared. The other values in msgAndArgs
+// are used as the message, as for any other assertion.
+//
+//    require.EqualWith(t, expected, actual, assert.IgnoreFields("CreatedAt"), assert.EquateEmpty())
+func EqualWith(t TestingT, expected, actual interface{}, msgAndArgs ...interface{}) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+
+	if !assert.EqualWith(t, expected, actual, msgAndArgs...) {
+		t.FailNow()
+	}
+}
+
+// NotNil asserts that the specified object is not nil.
+//
+//    require.NotNil(t, err, "err should be something")
+func NotNil(t TestingT, object interface{}, msgAndArgs ...interface{}) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+
+	if !assert.NotNil(t, object, msgAndArgs...) {
+		t.FailNow()
+	}
+}
+
+// Nil asserts that the specified object is nil.
+//
+//    require.Nil(t, err, "err should be nothing")
+func Nil(t TestingT, object interface{}, msgAndArgs ...interface{}) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+
+	if !assert.Nil(t, object, msgAndArgs...) {
+		t.FailNow()
+	}
+}
+
+// Error asserts that a function returned an error (i.e. not nil).
+//
+//    actualObj, err := SomeFunction()
+//    require.Error(t, err, "An error was expected")
+func Error(t TestingT, err error, msgAndArgs ...interface{}) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+
+	if !assert.Error(t, err, msgAndArgs...) {
+		t.FailNow()
+	}
+}
+
+// NoError asserts that a function returned no error (i.e. nil).
+//
+//    actualObj, err := SomeFunction()
+//    require.NoError(t, err)
+//    require.Equal(t, expectedObj, actualObj)
+func NoError(t TestingT, err error, msgAndArgs ...interface{}) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+
+	if !assert.NoError(t, err, msgAndArgs...) {
+		t.FailNow()
+	}
+}
+
+// ErrorIs asserts that at least one of the errors in err's chain matches
+// target, using errors.Is.
+//
+//    require.ErrorIs(t, err, fs.ErrNotExist)
+func ErrorIs(t TestingT, err, target error, msgAndArgs ...interface{}) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+
+	if !assert.ErrorIs(t, err, target, msgAndArgs...) {
+		t.FailNow()
+	}
+}
+
+// NotErrorIs asserts that none of the errors in err's chain match target,
+// using errors.Is.
+//
+//    require.NotErrorIs(t, err, fs.ErrNotExist)
+func NotErrorIs(t TestingT, err, target error, msgAndArgs ...interface{}) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+
+	if !assert.NotErrorIs(t, err, target, msgAndArgs...) {
+		t.FailNow()
+	}
+}
+
+// ErrorAs asserts that at least one of the errors in err's chain matches
+// target, using errors.As, and if so sets target to that error.
+//
+//    var pathErr *fs.PathError
+//    require.ErrorAs(t, err, &pathErr)
+func ErrorAs(t TestingT, err error, target interface{}, msgAndArgs ...interface{}) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+
+	if !assert.ErrorAs(t, err, target, msgAndArgs...) {
+		t.FailNow()
+	}
+}
+
+// EqualError asserts that a function returned an error (i.e. not nil) and that
+// it is equal to the provided error message.
+//
+//    actualObj, err := SomeFunction()
+//    require.EqualError(t, err, expectedErrorString, "An error was expected")
+func EqualError(t TestingT, theError error, errString string, msgAndArgs ...interface{}) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+
+	if !assert.EqualError(t, theError, errString, msgAndArgs...) {
+		t.FailNow()
+	}
+}
+
+// ErrorContains asserts that a function returned an error (i.e. not nil) and
+// that its message contains the specified substring.
+//
+//    actualObj, err := SomeFunction()
+//    require.ErrorContains(t, err, "not found")
+func ErrorContains(t TestingT, theError error, contains string, msgAndArgs ...interface{}) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+
+	if !assert.ErrorContains(t, theError, contains, msgAndArgs...) {
+		t.FailNow()
+	}
+}
+
+// Empty asserts that the specified object is empty.  I.e. nil, "", false, 0 or either
+// a slice or a channel with len == 0.
+//
+// require.Empty(t, obj)
+func Empty(t TestingT, object interface{}, msgAndArgs ...interface{}) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+
+	if !assert.Empty(t, object, msgAndArgs...) {
+		t.FailNow()
+	}
+}
+
+// NotEmpty asserts that the specified object is NOT empty.  I.e. not nil, "", false, 0 or either
+// a slice or a channel with len == 0.
+//
+// if require.NotEmpty(t, obj) {
+//   assert.Equal(t, "two", obj[1])
+// }
+func NotEmpty(t TestingT, object interface{}, msgAndArgs ...interface{}) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+
+	if !assert.NotEmpty(t, object, msgAndArgs...) {
+		t.FailNow()
+	}
+}
+
+// Len asserts that the specified object has specific length.
+// Len also fails if the object has a type that len() not accept.
+//
+//    require.Len(t, mySlice, 3, "The size of slice is not 3")
+func Len(t TestingT, object interface{}, length int, msgAndArgs ...interface{}) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+
+	if !assert.Len(t, object, length, msgAndArgs...) {
+		t.FailNow()
+	}
+}
+
+// True asserts that the specified value is true.
+//
+//    require.True(t, myBool, "myBool should be true")
+func True(t TestingT, value bool, msgAndArgs ...interface{}) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+
+	if !assert.True(t, value, msgAndArgs...) {
+		t.FailNow()
+	}
+}
+
+// False asserts that the specified value is true.
+//
+//    require.False(t, myBool, "myBool should be false")
+func False(t TestingT, value bool, msgAndArgs ...interface{}) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+
+	if !assert.False(t, value, msgAndArgs...) {
+		t.FailNow()
+	}
+}
+
+// NotEqual asserts that the specified values are NOT equal.
+//
+//    require.NotEqual(t, obj1, obj2, "two objects shouldn't be equal")
+func NotEqual(t TestingT, expected, actual interface{}, msgAndArgs ...interface{}) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+
+	if !assert.NotEqual(t, expected, actual, msgAndArgs...) {
+		t.FailNow()
+	}
+}
+
+// Contains asserts that the specified string or list(array, slice...) contains the
+// specified substring or element.
+//
+//    require.Contains(t, "Hello World", "World", "But 'Hello World' does contain 'World'")
+//    require.Contains(t, ["Hello", "World"], "World", "But ["Hello", "World"] does contain 'World'")
+func Contains(t TestingT, s, contains interface{}, msgAndArgs ...interface{}) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+
+	if !assert.Contains(t, s, contains, msgAndArgs...) {
+		t.FailNow()
+	}
+}
+
+// NotContains asserts that the specified string or list(array, slice...) does NOT contain the
+// specified substring or element.
+//
+//    require.NotContains(t, "Hello World", "Earth", "But 'Hello World' does NOT contain 'Earth'")
+//    require.NotContains(t, ["Hello", "World"], "Earth", "But ['Hello', 'World'] does NOT contain 'Earth'")
+func NotContains(t TestingT, s, contains interface{}, msgAndArgs ...interface{}) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+
+	if !assert.NotContains(t, s, contains, msgAndArgs...) {
+		t.FailNow()
+	}
+}
+
+// ElementsMatch asserts that the specified lists (arrays or slices) contain the
+// same elements, ignoring their order. Duplicates must appear the same number of
+// times in each list.
+//
+//    require.ElementsMatch(t, []int{1, 3, 2, 3}, []int{3, 3, 1, 2})
+func ElementsMatch(t TestingT, expected, actual interface{}, msgAndArgs ...interface{}) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+
+	if !assert.ElementsMatch(t, expected, actual, msgAndArgs...) {
+		t.FailNow()
+	}
+}
+
+// Subset asserts that the specified list (array or slice) contains all of the
+// elements of subset, or that the specified map contains all of the keys of
+// subset with the same values.
+//
+//    require.Subset(t, []int{1, 2, 3}, []int{1, 3})
+//    require.Subset(t, map[string]int{"a": 1, "b": 2}, map[string]int{"a": 1})
+func Subset(t TestingT, list, subset interface{}, msgAndArgs ...interface{}) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+
+	if !assert.Subset(t, list, subset, msgAndArgs...) {
+		t.FailNow()
+	}
+}
+
+// NotSubset asserts that the specified list (array or slice) does NOT contain
+// all of the elements of subset, or that the specified map does NOT contain all
+// of the entries of subset.
+//
+//    require.NotSubset(t, []int{1, 2, 3}, []int{1, 4})
+//    require.NotSubset(t, map[string]int{"a": 1, "b": 2}, map[string]int{"a": 2})
+func NotSubset(t TestingT, list, subset interface{}, msgAndArgs ...interface{}) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+
+	if !assert.NotSubset(t, list, subset, msgAndArgs...) {
+		t.FailNow()
+	}
+}
+
+// HasKey asserts that the specified map contains the key.
+//
+//    require.HasKey(t, map[string]int{"a": 1}, "a")
+func HasKey(t TestingT, m, key interface{}, msgAndArgs ...interface{}) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+
+	if !assert.HasKey(t, m, key, msgAndArgs...) {
+		t.FailNow()
+	}
+}
+
+// NotHasKey asserts that the specified map does NOT contain the key.
+//
+//    require.NotHasKey(t, map[string]int{"a": 1}, "b")
+func NotHasKey(t TestingT, m, key interface{}, msgAndArgs ...interface{}) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+
+	if !assert.NotHasKey(t, m, key, msgAndArgs...) {
+		t.FailNow()
+	}
+}
+
+// HasEntry asserts that the specified map contains the key, and that its value
+// is equal to the expected value.
+//
+//    require.HasEntry(t, map[string]int{"a": 1}, "a", 1)
+func HasEntry(t TestingT, m, key, expected interface{}, msgAndArgs ...interface{}) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+
+	if !assert.HasEntry(t, m, key, expected, msgAndArgs...) {
+		t.FailNow()
+	}
+}
+
+// Condition uses a Comparison to assert a complex condition.
+func Condition(t TestingT, comp assert.Comparison, msgAndArgs ...interface{}) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+
+	if !assert.Condition(t, comp, msgAndArgs...) {
+		t.FailNow()
+	}
+}
+
+// That asserts that actual matches the Matcher.
+//
+//    require.That(t, events, assert.Each(assert.AllOf(
+//      assert.HasField("Status", "ok"),
+//      assert.HasField("ID", assert.MatchesRegexp("^evt_")),
+//    )))
+func That(t TestingT, actual interface{}, matcher assert.Matcher, msgAndArgs ...interface{}) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+
+	if !assert.That(t, actual, matcher, msgAndArgs...) {
+		t.FailNow()
+	}
+}
+
+// Eventually asserts that the Comparison returns true within waitFor, calling
+// it every tick.
+//
+//    require.Eventually(t, func() bool { return cache.Len() == 3 }, time.Second, 10*time.Millisecond)
+func Eventually(t TestingT, comp assert.Comparison, waitFor, tick time.Duration, msgAndArgs ...interface{}) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+
+	if !assert.Eventually(t, comp, waitFor, tick, msgAndArgs...) {
+		t.FailNow()
+	}
+}
+
+// Never asserts that the Comparison does not return true within waitFor,
+// calling it every tick.
+//
+//    require.Never(t, func() bool { return worker.Crashed() }, time.Second, 10*time.Millisecond)
+func Never(t TestingT, comp assert.Comparison, waitFor, tick time.Duration, msgAndArgs ...interface{}) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+
+	if !assert.Never(t, comp, waitFor, tick, msgAndArgs...) {
+		t.FailNow()
+	}
+}
+
+// Consistently asserts that the Comparison returns true every time it is
+// called, every tick, for the whole of waitFor.
+//
+//    require.Consistently(t, func() bool { return conn.Open() }, time.Second, 10*time.Millisecond)
+func Consistently(t TestingT, comp assert.Comparison, waitFor, tick time.Duration, msgAndArgs ...interface{}) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+
+	if !assert.Consistently(t, comp, waitFor, tick, msgAndArgs...) {
+		t.FailNow()
+	}
+}
+
+// EventuallyWithT asserts that all of the assertions made in condition pass
+// within waitFor, calling it every tick. Failures from attempts other than the
+// last are discarded.
+//
+//    require.EventuallyWithT(t, func(c *assert.CollectT) {
+//      assert.Equal(c, 3, cache.Len())
+//      assert.Contains(c, cache.Keys(), "a")
+//    }, time.Second, 10*time.Millisecond)
+func EventuallyWithT(t TestingT, condition func(c *assert.CollectT), waitFor, tick time.Duration, msgAndArgs ...interface{}) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+
+	if !assert.EventuallyWithT(t, condition, waitFor, tick, msgAndArgs...) {
+		t.FailNow()
+	}
+}
+
+// Panics asserts that the code inside the specified func panics.
+//
+//   require.Panics(t, func(){
+//     GoCrazy()
+//   }, "Calling GoCrazy() should panic")
+func Panics(t TestingT, f func(), msgAndArgs ...interface{}) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+
+	if !assert.Panics(t, f, msgAndArgs...) {
+		t.FailNow()
+	}
+}
+
+// PanicsWithValue asserts that the code inside the specified func panics, and
+// that the recovered panic value equals the expected value.
+//
+//   require.PanicsWithValue(t, "crazy error", func(){
+//     GoCrazy()
+//   }, "Calling GoCrazy() should panic with 'crazy error'")
+func PanicsWithValue(t TestingT, expected interface{}, f func(), msgAndArgs ...interface{}) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+
+	if !assert.PanicsWithValue(t, expected, f, msgAndArgs...) {
+		t.FailNow()
+	}
+}
+
+// PanicsWithError asserts that the code inside the specified func panics with an
+// error. If expected is an error the recovered error must match it using
+// errors.Is, otherwise the message of the recovered error must equal expected.
+//
+//   require.PanicsWithError(t, ErrCrazy, func(){
+//     GoCrazy()
+//   })
+//   require.PanicsWithError(t, "crazy error", func(){
+//     GoCrazy()
+//   })
+func PanicsWithError(t TestingT, expected interface{}, f func(), msgAndArgs ...interface{}) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+
+	if !assert.PanicsWithError(t, expected, f, msgAndArgs...) {
+		t.FailNow()
+	}
+}
+
+// PanicsMatching asserts that the code inside the specified func panics, and
+// that the recovered panic value matches the regexp. Errors are matched using
+// their message, and all other values are formatted with %v.
+//
+//   require.PanicsMatching(t, "index out of range", func(){
+//     GoCrazy()
+//   })
+func PanicsMatching(t TestingT, rx interface{}, f func(), msgAndArgs ...interface{}) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+
+	if !assert.PanicsMatching(t, rx, f, msgAndArgs...) {
+		t.FailNow()
+	}
+}
+
+// NotPanics asserts that the code inside the specified func does NOT panic.
+//
+//   require.NotPanics(t, func(){
+//     RemainCalm()
+//   }, "Calling RemainCalm() should NOT panic")
+func NotPanics(t TestingT, f func(), msgAndArgs ...interface{}) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+
+	if !assert.NotPanics(t, f, msgAndArgs...) {
+		t.FailNow()
+	}
+}
+
+// WithinDuration asserts that the two times are within duration delta of each other.
+//
+//   require.WithinDuration(t, time.Now(), time.Now(), 10*time.Second, "The difference should not be more than 10s")
+func WithinDuration(t TestingT, expected, actual time.Time, delta time.Duration, msgAndArgs ...interface{}) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+
+	if !assert.WithinDuration(t, expected, actual, delta, msgAndArgs...) {
+		t.FailNow()
+	}
+}
+
+// InDelta asserts that the two numerals are within delta of each other.
+//
+// 	 require.InDelta(t, math.Pi, (22 / 7.0), 0.01)
+func InDelta(t TestingT, expected, actual interface{}, delta float64, msgAndArgs ...interface{}) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+
+	if !assert.InDelta(t, expected, actual, delta, msgAndArgs...) {
+		t.FailNow()
+	}
+}
+
+// InDeltaSlice is the same as InDelta, except it compares two slices.
+func InDeltaSlice(t TestingT, expected, actual interface{}, delta float64, msgAndArgs ...interface{}) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+
+	if !assert.InDeltaSlice(t, expected, actual, delta, msgAndArgs...) {
+		t.FailNow()
+	}
+}
+
+// InEpsilon asserts that expected and actual have a relative error less than epsilon
+func InEpsilon(t TestingT, expected, actual interface{}, epsilon float64, msgAndArgs ...interface{}) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+
+	if !assert.InEpsilon(t, expected, actual, epsilon, msgAndArgs...) {
+		t.FailNow()
+	}
+}
+
+// InEpsilonSlice is the same as InEpsilon, except it compares two slices.
+func InEpsilonSlice(t TestingT, expected, actual interface{}, epsilon float64, msgAndArgs ...interface{}) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+
+	if !assert.InEpsilonSlice(t, expected, actual, epsilon, msgAndArgs...) {
+		t.FailNow()
+	}
+}
+
+// Greater asserts that the first element is greater than the second. Both must
+// be the same type, which can be any number, string, time.Time or big.Int.
+//
+//    require.Greater(t, 2, 1)
+//    require.Greater(t, "b", "a")
+//    require.Greater(t, time.Now(), start)
+func Greater(t TestingT, e1, e2 interface{}, msgAndArgs ...interface{}) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+
+	if !assert.Greater(t, e1, e2, msgAndArgs...) {
+		t.FailNow()
+	}
+}
+
+// GreaterOrEqual asserts that the first element is greater than or equal to the
+// second.
+//
+//    require.GreaterOrEqual(t, 2, 1)
+//    require.GreaterOrEqual(t, 2, 2)
+func GreaterOrEqual(t TestingT, e1, e2 interface{}, msgAndArgs ...interface{}) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+
+	if !assert.GreaterOrEqual(t, e1, e2, msgAndArgs...) {
+		t.FailNow()
+	}
+}
+
+// Less asserts that the first element is less than the second.
+//
+//    require.Less(t, 1, 2)
+//    require.Less(t, time.Second, timeout)
+func Less(t TestingT, e1, e2 interface{}, msgAndArgs ...interface{}) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+
+	if !assert.Less(t, e1, e2, msgAndArgs...) {
+		t.FailNow()
+	}
+}
+
+// LessOrEqual asserts that the first element is less than or equal to the
+// second.
+//
+//    require.LessOrEqual(t, 1, 2)
+//    require.LessOrEqual(t, 2, 2)
+func LessOrEqual(t TestingT, e1, e2 interface{}, msgAndArgs ...interface{}) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+
+	if !assert.LessOrEqual(t, e1, e2, msgAndArgs...) {
+		t.FailNow()
+	}
+}
+
+// Positive asserts that the specified number, time.Duration or big.Int is
+// greater than zero.
+//
+//    require.Positive(t, 1)
+//    require.Positive(t, elapsed)
+func Positive(t TestingT, e interface{}, msgAndArgs ...interface{}) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+
+	if !assert.Positive(t, e, msgAndArgs...) {
+		t.FailNow()
+	}
+}
+
+// Negative asserts that the specified number, time.Duration or big.Int is less
+// than zero.
+//
+//    require.Negative(t, -1)
+func Negative(t TestingT, e interface{}, msgAndArgs ...interface{}) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+
+	if !assert.Negative(t, e, msgAndArgs...) {
+		t.FailNow()
+	}
+}
+
+// Zero asserts that the specified value is zero. Numbers, time.Duration and
+// big.Int are zero when they equal 0, values with an IsZero() bool method, such
+// as time.Time, when it returns true, and any other value when it is the zero
+// value of its type, such as "" or nil.
+//
+//    require.Zero(t, balance.Sub(credits))
+func Zero(t TestingT, e interface{}, msgAndArgs ...interface{}) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+
+	if !assert.Zero(t, e, msgAndArgs...) {
+		t.FailNow()
+	}
+}
+
+// NotZero asserts that the specified value is not zero, as described for Zero.
+//
+//    require.NotZero(t, elapsed)
+func NotZero(t TestingT, e interface{}, msgAndArgs ...interface{}) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+
+	if !assert.NotZero(t, e, msgAndArgs...) {
+		t.FailNow()
+	}
+}
+
+// IsSorted asserts that the specified list is sorted in ascending order, allowing
+// equal elements. The list can be an array or slice of any type accepted by
+// Greater, or a sort.Interface.
+//
+//    require.IsSorted(t, []string{"a", "b", "b"})
+//    require.IsSorted(t, sort.IntSlice(ids))
+func IsSorted(t TestingT, list interface{}, msgAndArgs ...interface{}) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+
+	if !assert.IsSorted(t, list, msgAndArgs...) {
+		t.FailNow()
+	}
+}
+
+// IsSortedBy asserts that the specified list is sorted according to less, which
+// must be of the form func(a, b T) bool where T is the type of the elements.
+//
+//    require.IsSortedBy(t, events, func(a, b Event) bool {
+//      return a.At.Before(b.At)
+//    })
+func IsSortedBy(t TestingT, list, less interface{}, msgAndArgs ...interface{}) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+
+	if !assert.IsSortedBy(t, list, less, msgAndArgs...) {
+		t.FailNow()
+	}
+}
+
+// IsIncreasing asserts that each element of the specified list is greater than
+// the one before it.
+//
+//    require.IsIncreasing(t, []int{1, 2, 3})
+func IsIncreasing(t TestingT, list interface{}, msgAndArgs ...interface{}) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+
+	if !assert.IsIncreasing(t, list, msgAndArgs...) {
+		t.FailNow()
+	}
+}
+
+// IsNonDecreasing asserts that each element of the specified list is greater
+// than or equal to the one before it.
+//
+//    require.IsNonDecreasing(t, []int{1, 1, 2})
+func IsNonDecreasing(t TestingT, list interface{}, msgAndArgs ...interface{}) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+
+	if !assert.IsNonDecreasing(t, list, msgAndArgs...) {
+		t.FailNow()
+	}
+}
+
+// IsDecreasing asserts that each element of the specified list is less than the
+// one before it.
+//
+//    require.IsDecreasing(t, []int{3, 2, 1})
+func IsDecreasing(t TestingT, list interface{}, msgAndArgs ...interface{}) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+
+	if !assert.IsDecreasing(t, list, msgAndArgs...) {
+		t.FailNow()
+	}
+}
+
+// Regexp asserts that a specified regexp matches a string.
+//
+//  require.Regexp(t, regexp.MustCompile("start"), "it's starting")
+//  require.Regexp(t, "start...$", "it's not starting")
+func Regexp(t TestingT, rx interface{}, str interface{}, msgAndArgs ...interface{}) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+
+	if !assert.Regexp(t, rx, str, msgAndArgs...) {
+		t.FailNow()
+	}
+}
+
+// NotRegexp asserts that a specified regexp does not match a string.
+//
+//  require.NotRegexp(t, regexp.MustCompile("starts"), "it's starting")
+//  require.NotRegexp(t, "^start", "it's not starting")
+func NotRegexp(t TestingT, rx interface{}, str interface{}, msgAndArgs ...interface{}) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+
+	if !assert.NotRegexp(t, rx, str, msgAndArgs...) {
+		t.FailNow()
+	}
+}
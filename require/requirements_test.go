@@ -0,0 +1,185 @@
+package require
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"testing"
+	"time"
+
+	"hawx.me/code/assert"
+)
+
+// MockT records failures and whether FailNow was called, without stopping
+// the test.
+type MockT struct {
+	buf    bytes.Buffer
+	Failed bool
+}
+
+func (t *MockT) FailNow() {
+	t.Failed = true
+}
+
+func (t *MockT) Errorf(format string, args ...interface{}) {
+	fmt.Fprintf(&t.buf, format, args...)
+}
+
+func TestRequirementsPass(t *testing.T) {
+	mockT := new(MockT)
+	now := time.Now()
+
+	Implements(mockT, (*error)(nil), errors.New("err"))
+	IsType(mockT, 1, 2)
+	Equal(mockT, 1, 1)
+	Equivalent(mockT, int32(1), int64(1))
+	Exactly(mockT, 1, 1)
+	EqualWith(mockT, []int{}, []int(nil), assert.EquateEmpty())
+	NotNil(mockT, 1)
+	Nil(mockT, nil)
+	ElementsMatch(mockT, []int{1, 2}, []int{2, 1})
+	Greater(mockT, 2, 1)
+	GreaterOrEqual(mockT, 1, 1)
+	Less(mockT, 1, 2)
+	LessOrEqual(mockT, 1, 1)
+	Positive(mockT, 1)
+	Negative(mockT, -1)
+	Zero(mockT, 0)
+	NotZero(mockT, 1)
+	IsSorted(mockT, []int{1, 2})
+	IsSortedBy(mockT, []int{2, 1}, func(a, b int) bool { return a > b })
+	IsIncreasing(mockT, []int{1, 2})
+	IsNonDecreasing(mockT, []int{1, 1})
+	IsDecreasing(mockT, []int{2, 1})
+	That(mockT, []int{1}, assert.HasLen(1))
+	Subset(mockT, []int{1, 2}, []int{2})
+	NotSubset(mockT, []int{1, 2}, []int{3})
+	HasKey(mockT, map[string]int{"a": 1}, "a")
+	NotHasKey(mockT, map[string]int{"a": 1}, "b")
+	HasEntry(mockT, map[string]int{"a": 1}, "a", 1)
+	PanicsWithValue(mockT, 1, func() { panic(1) })
+	PanicsWithError(mockT, io.EOF, func() { panic(io.EOF) })
+	PanicsMatching(mockT, "^a", func() { panic("abc") })
+	Error(mockT, assert.AnError)
+	NoError(mockT, nil)
+	ErrorIs(mockT, assert.AnError, assert.AnError)
+	NotErrorIs(mockT, assert.AnError, io.EOF)
+	ErrorAs(mockT, assert.AnError, new(error))
+	EqualError(mockT, io.EOF, "EOF")
+	ErrorContains(mockT, io.EOF, "O")
+	Empty(mockT, "")
+	NotEmpty(mockT, "a")
+	Len(mockT, []int{1}, 1)
+	True(mockT, true)
+	False(mockT, false)
+	NotEqual(mockT, 1, 2)
+	Contains(mockT, "abc", "b")
+	NotContains(mockT, "abc", "d")
+	Condition(mockT, func() bool { return true })
+	Eventually(mockT, func() bool { return true }, time.Second, time.Millisecond)
+	Never(mockT, func() bool { return false }, time.Millisecond, time.Millisecond)
+	Consistently(mockT, func() bool { return true }, time.Millisecond, time.Millisecond)
+	EventuallyWithT(mockT, func(c *assert.CollectT) { Equal(c, 1, 1) }, time.Second, time.Millisecond)
+	Panics(mockT, func() { panic("!") })
+	NotPanics(mockT, func() {})
+	WithinDuration(mockT, now, now.Add(time.Second), 2*time.Second)
+	InDelta(mockT, 1.0, 1.1, 0.2)
+	InDeltaSlice(mockT, []float64{1.0}, []float64{1.1}, 0.2)
+	InEpsilon(mockT, 100, 101, 0.1)
+	InEpsilonSlice(mockT, []float64{100}, []float64{101}, 0.1)
+	Regexp(mockT, "^a", "abc")
+	NotRegexp(mockT, "^b", "abc")
+
+	if mockT.Failed {
+		t.Errorf("Passing requirements should not call FailNow:\n%s", mockT.buf.String())
+	}
+}
+
+func TestRequirementsFail(t *testing.T) {
+	now := time.Now()
+
+	cases := map[string]func(t TestingT){
+		"Fail":            func(t TestingT) { Fail(t, "failed") },
+		"Implements":      func(t TestingT) { Implements(t, (*error)(nil), 1) },
+		"IsType":          func(t TestingT) { IsType(t, 1, "2") },
+		"Equal":           func(t TestingT) { Equal(t, 1, 2) },
+		"Equivalent":      func(t TestingT) { Equivalent(t, int32(1), int64(2)) },
+		"Exactly":         func(t TestingT) { Exactly(t, int32(1), int64(1)) },
+		"EqualWith":       func(t TestingT) { EqualWith(t, []int{}, []int(nil)) },
+		"NotNil":          func(t TestingT) { NotNil(t, nil) },
+		"Nil":             func(t TestingT) { Nil(t, 1) },
+		"Error":           func(t TestingT) { Error(t, nil) },
+		"NoError":         func(t TestingT) { NoError(t, io.EOF) },
+		"ErrorIs":         func(t TestingT) { ErrorIs(t, io.EOF, assert.AnError) },
+		"NotErrorIs":      func(t TestingT) { NotErrorIs(t, io.EOF, io.EOF) },
+		"ErrorAs":         func(t TestingT) { ErrorAs(t, io.EOF, new(*fs.PathError)) },
+		"EqualError":      func(t TestingT) { EqualError(t, io.EOF, "EOF!") },
+		"ErrorContains":   func(t TestingT) { ErrorContains(t, io.EOF, "!") },
+		"Empty":           func(t TestingT) { Empty(t, "a") },
+		"ElementsMatch":   func(t TestingT) { ElementsMatch(t, []int{1}, []int{2}) },
+		"Greater":         func(t TestingT) { Greater(t, 1, 2) },
+		"GreaterOrEqual":  func(t TestingT) { GreaterOrEqual(t, 1, 2) },
+		"Less":            func(t TestingT) { Less(t, 2, 1) },
+		"LessOrEqual":     func(t TestingT) { LessOrEqual(t, 2, 1) },
+		"Positive":        func(t TestingT) { Positive(t, 0) },
+		"Negative":        func(t TestingT) { Negative(t, 0) },
+		"Zero":            func(t TestingT) { Zero(t, 1) },
+		"NotZero":         func(t TestingT) { NotZero(t, 0) },
+		"IsSorted":        func(t TestingT) { IsSorted(t, []int{2, 1}) },
+		"IsSortedBy":      func(t TestingT) { IsSortedBy(t, []int{1, 2}, func(a, b int) bool { return a > b }) },
+		"IsIncreasing":    func(t TestingT) { IsIncreasing(t, []int{1, 1}) },
+		"IsNonDecreasing": func(t TestingT) { IsNonDecreasing(t, []int{2, 1}) },
+		"IsDecreasing":    func(t TestingT) { IsDecreasing(t, []int{1, 1}) },
+		"That":            func(t TestingT) { That(t, []int{1}, assert.HasLen(2)) },
+		"Subset":          func(t TestingT) { Subset(t, []int{1}, []int{2}) },
+		"NotSubset":       func(t TestingT) { NotSubset(t, []int{1}, []int{1}) },
+		"HasKey":          func(t TestingT) { HasKey(t, map[string]int{}, "a") },
+		"NotHasKey":       func(t TestingT) { NotHasKey(t, map[string]int{"a": 1}, "a") },
+		"HasEntry":        func(t TestingT) { HasEntry(t, map[string]int{"a": 1}, "a", 2) },
+		"PanicsWithValue": func(t TestingT) { PanicsWithValue(t, 1, func() { panic(2) }) },
+		"PanicsWithError": func(t TestingT) { PanicsWithError(t, io.EOF, func() {}) },
+		"PanicsMatching":  func(t TestingT) { PanicsMatching(t, "^b", func() { panic("abc") }) },
+		"NotEmpty":        func(t TestingT) { NotEmpty(t, "") },
+		"Len":             func(t TestingT) { Len(t, []int{1}, 2) },
+		"True":            func(t TestingT) { True(t, false) },
+		"False":           func(t TestingT) { False(t, true) },
+		"NotEqual":        func(t TestingT) { NotEqual(t, 1, 1) },
+		"Contains":        func(t TestingT) { Contains(t, "abc", "d") },
+		"NotContains":     func(t TestingT) { NotContains(t, "abc", "b") },
+		"Condition":       func(t TestingT) { Condition(t, func() bool { return false }) },
+		"Eventually":      func(t TestingT) { Eventually(t, func() bool { return false }, 0, time.Millisecond) },
+		"Never":           func(t TestingT) { Never(t, func() bool { return true }, time.Second, time.Millisecond) },
+		"Consistently":    func(t TestingT) { Consistently(t, func() bool { return false }, time.Second, time.Millisecond) },
+		"EventuallyWithT": func(t TestingT) {
+			EventuallyWithT(t, func(c *assert.CollectT) { Equal(c, 1, 2) }, 0, time.Millisecond)
+		},
+		"Panics":         func(t TestingT) { Panics(t, func() {}) },
+		"NotPanics":      func(t TestingT) { NotPanics(t, func() { panic("!") }) },
+		"WithinDuration": func(t TestingT) { WithinDuration(t, now, now.Add(time.Second), time.Millisecond) },
+		"InDelta":        func(t TestingT) { InDelta(t, 1.0, 2.0, 0.5) },
+		"InDeltaSlice":   func(t TestingT) { InDeltaSlice(t, []float64{1.0}, []float64{2.0}, 0.5) },
+		"InEpsilon":      func(t TestingT) { InEpsilon(t, 100, 200, 0.1) },
+		"InEpsilonSlice": func(t TestingT) { InEpsilonSlice(t, []float64{100}, []float64{200}, 0.1) },
+		"Regexp":         func(t TestingT) { Regexp(t, "^b", "abc") },
+		"NotRegexp":      func(t TestingT) { NotRegexp(t, "^a", "abc") },
+	}
+
+	for name, f := range cases {
+		mockT := new(MockT)
+		f(mockT)
+
+		if !mockT.Failed {
+			t.Errorf("%s should call FailNow", name)
+		}
+	}
+}
+
+func TestRequirementsErrorTrace(t *testing.T) {
+	mockT := new(MockT)
+	Equal(mockT, 1, 2)
+
+	assert.Contains(t, mockT.buf.String(), "Error Trace:\trequirements_test.go:")
+	assert.NotContains(t, mockT.buf.String(), "requirements.go:")
+}
@@ -0,0 +1,57 @@
+package require
+
+import "hawx.me/code/assert"
+
+// EqualT asserts that two values of the same type are equal. Unlike Equal,
+// passing values of different types is a compile error.
+//
+//    require.EqualT(t, int64(123), int64(123), "123 and 123 should be equal")
+func EqualT[T comparable](t TestingT, expected, actual T, msgAndArgs ...interface{}) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+
+	if !assert.EqualT(t, expected, actual, msgAndArgs...) {
+		t.FailNow()
+	}
+}
+
+// NotEqualT asserts that two values of the same type are NOT equal.
+//
+//    require.NotEqualT(t, obj1, obj2, "two objects shouldn't be equal")
+func NotEqualT[T comparable](t TestingT, expected, actual T, msgAndArgs ...interface{}) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+
+	if !assert.NotEqualT(t, expected, actual, msgAndArgs...) {
+		t.FailNow()
+	}
+}
+
+// ContainsT asserts that the list contains the element.
+//
+//    require.ContainsT(t, []string{"Hello", "World"}, "World")
+func ContainsT[T comparable](t TestingT, list []T, element T, msgAndArgs ...interface{}) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+
+	if !assert.ContainsT(t, list, element, msgAndArgs...) {
+		t.FailNow()
+	}
+}
+
+// ElementsOf asserts that actual has the same elements as expected, in the same
+// order.
+//
+//    require.ElementsOf(t, []int{1, 2, 3}, ids)
+func ElementsOf[T comparable](t TestingT, expected, actual []T, msgAndArgs ...interface{}) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+
+	if !assert.ElementsOf(t, expected, actual, msgAndArgs...) {
+		t.FailNow()
+	}
+}
@@ -0,0 +1,32 @@
+package require
+
+import "testing"
+
+func TestTypedRequirements(t *testing.T) {
+	mockT := new(MockT)
+
+	EqualT(mockT, int64(1), 1)
+	NotEqualT(mockT, "a", "b")
+	ContainsT(mockT, []int{1, 2}, 2)
+	ElementsOf(mockT, []int{1, 2}, []int{1, 2})
+
+	if mockT.Failed {
+		t.Errorf("Passing requirements should not call FailNow:\n%s", mockT.buf.String())
+	}
+
+	cases := map[string]func(t TestingT){
+		"EqualT":     func(t TestingT) { EqualT(t, int64(1), 2) },
+		"NotEqualT":  func(t TestingT) { NotEqualT(t, "a", "a") },
+		"ContainsT":  func(t TestingT) { ContainsT(t, []int{1, 2}, 3) },
+		"ElementsOf": func(t TestingT) { ElementsOf(t, []int{1, 2}, []int{2, 1}) },
+	}
+
+	for name, f := range cases {
+		mockT := new(MockT)
+		f(mockT)
+
+		if !mockT.Failed {
+			t.Errorf("%s should call FailNow", name)
+		}
+	}
+}
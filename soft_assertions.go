@@ -0,0 +1,158 @@
+package assert
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+)
+
+// softT is a TestingT that collects the failures reported to it, instead of
+// passing them on.
+type softT struct {
+	mu       sync.Mutex
+	failures []string
+}
+
+func (s *softT) Errorf(format string, args ...interface{}) {
+	s.mu.Lock()
+	s.failures = append(s.failures, strings.Trim(fmt.Sprintf(format, args...), "\n"))
+	s.mu.Unlock()
+}
+
+// Helper does nothing, but means that Fail formats failures for a testing
+// framework that reports the call site itself.
+func (s *softT) Helper() {}
+
+// formatFailures numbers each of the failures collected by a softT.
+func formatFailures(failures []string) string {
+	report := make([]string, len(failures))
+	for i, failure := range failures {
+		report[i] = fmt.Sprintf("Failure %d of %d:\n%s", i+1, len(failures), failure)
+	}
+
+	return strings.Join(report, "\n\n")
+}
+
+// SoftAssertions provides the same methods as Assertions, but collects any
+// failures so that they can be reported together by Check.
+type SoftAssertions struct {
+	*Assertions
+	t    TestingT
+	soft *softT
+}
+
+// Soft makes a new SoftAssertions object for the specified TestingT. Nothing is
+// reported to 't' until Check or CheckNow is called.
+//
+//    assert := assert.Soft(t)
+//    defer assert.Check()
+//
+//    assert.Equal(1, resp.ID)
+//    assert.Equal("ok", resp.Status)
+func Soft(t TestingT) *SoftAssertions {
+	soft := &softT{}
+
+	return &SoftAssertions{
+		Assertions: New(soft),
+		t:          t,
+		soft:       soft,
+	}
+}
+
+// Check reports any failures collected so far as a single numbered failure,
+// then clears them.
+//
+// Returns whether all of the assertions were successful (true) or not (false).
+func (s *SoftAssertions) Check() bool {
+	if h, ok := s.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	s.soft.mu.Lock()
+	failures := s.soft.failures
+	s.soft.failures = nil
+	s.soft.mu.Unlock()
+
+	if len(failures) == 0 {
+		return true
+	}
+
+	return Fail(s.t, fmt.Sprintf("%d assertion(s) failed\n\n%s", len(failures), formatFailures(failures)))
+}
+
+// CheckNow is the same as Check, but stops the test with FailNow if any of the
+// assertions failed and 't' supports it.
+func (s *SoftAssertions) CheckNow() {
+	if h, ok := s.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	if !s.Check() {
+		if f, ok := s.t.(failNower); ok {
+			f.FailNow()
+		}
+	}
+}
+
+// All runs f with Assertions that collect their failures, then reports all of
+// the failures together when f returns.
+//
+//    assert.All(t, func(assert *assert.Assertions) {
+//      assert.Equal(1, resp.ID)
+//      assert.Equal("ok", resp.Status)
+//    })
+//
+// Returns whether all of the assertions were successful (true) or not (false).
+func All(t TestingT, f func(a *Assertions)) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+
+	soft := Soft(t)
+	f(soft.Assertions)
+
+	return soft.Check()
+}
+
+// CollectT is the TestingT given to the function passed to EventuallyWithT. It
+// collects the failures of a single attempt.
+type CollectT struct {
+	soft   softT
+	failed bool
+}
+
+// collectFailNow is used to stop an attempt when FailNow is called.
+type collectFailNow struct{}
+
+// Errorf records a failure for the current attempt.
+func (c *CollectT) Errorf(format string, args ...interface{}) {
+	c.failed = true
+	c.soft.Errorf(format, args...)
+}
+
+// Helper does nothing, see softT.
+func (c *CollectT) Helper() {}
+
+// FailNow marks the current attempt as failed and stops it.
+func (c *CollectT) FailNow() {
+	c.failed = true
+	panic(collectFailNow{})
+}
+
+// Failed returns whether any failures have been reported in this attempt.
+func (c *CollectT) Failed() bool {
+	return c.failed
+}
+
+// run calls condition with c, stopping early if FailNow is called.
+func (c *CollectT) run(condition func(c *CollectT)) {
+	defer func() {
+		if r := recover(); r != nil {
+			if _, ok := r.(collectFailNow); !ok {
+				panic(r)
+			}
+		}
+	}()
+
+	condition(c)
+}
@@ -0,0 +1,74 @@
+package assert
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestSoft(t *testing.T) {
+	mockT := new(failNowT)
+	soft := Soft(mockT)
+
+	soft.Equal(1, 1)
+	soft.Equal(1, 2)
+	soft.Contains("abc", "d", "checking %s", "contains")
+
+	if mockT.buf.Len() != 0 {
+		t.Error("Soft should not report failures before Check")
+	}
+
+	if soft.Check() {
+		t.Error("Check should return false")
+	}
+	if mockT.failedNow {
+		t.Error("Check should not call FailNow")
+	}
+
+	output := mockT.buf.String()
+	Contains(t, output, "2 assertion(s) failed")
+	Contains(t, output, "Failure 1 of 2:")
+	Contains(t, output, "Not equal: 1 (expected)")
+	Contains(t, output, "Failure 2 of 2:")
+	Contains(t, output, "checking contains")
+	Equal(t, 3, strings.Count(output, "soft_assertions_test.go:"), "each failure and Check should have a call site")
+
+	if !soft.Check() {
+		t.Error("Check should return true once failures have been reported")
+	}
+}
+
+func TestSoftCheckNow(t *testing.T) {
+	mockT := new(failNowT)
+	soft := Soft(mockT)
+
+	soft.True(true)
+	soft.CheckNow()
+	if mockT.failedNow {
+		t.Error("CheckNow should not call FailNow when successful")
+	}
+
+	soft.True(false)
+	soft.CheckNow()
+	if !mockT.failedNow {
+		t.Error("CheckNow should call FailNow when unsuccessful")
+	}
+}
+
+func TestAll(t *testing.T) {
+	mockT := new(bufferT)
+
+	if !All(mockT, func(a *Assertions) {
+		a.Equal(1, 1)
+	}) {
+		t.Error("All should return true")
+	}
+
+	if All(mockT, func(a *Assertions) {
+		a.Equal(1, 2)
+		a.Nil(1)
+	}) {
+		t.Error("All should return false")
+	}
+
+	Equal(t, 1, strings.Count(mockT.buf.String(), "2 assertion(s) failed"))
+}
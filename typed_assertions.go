@@ -0,0 +1,155 @@
+package assert
+
+import "fmt"
+
+// equalT compares a and b with ==. If T is, or contains, an interface type
+// holding values that can't be compared that way they are compared as by Equal
+// instead.
+func equalT[T comparable](a, b T) (equal bool) {
+	defer func() {
+		if recover() != nil {
+			equal = objectsAreEqual(a, b)
+		}
+	}()
+
+	return a == b
+}
+
+// EqualT asserts that two values of the same type are equal. Unlike Equal,
+// passing values of different types is a compile error.
+//
+//    assert.EqualT(t, int64(123), int64(123), "123 and 123 should be equal")
+//
+// Returns whether the assertion was successful (true) or not (false).
+func EqualT[T comparable](t TestingT, expected, actual T, msgAndArgs ...interface{}) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+
+	if !equalT(expected, actual) {
+		return Fail(t, equalFailureMessage(expected, actual), msgAndArgs...)
+	}
+
+	return true
+}
+
+// NotEqualT asserts that two values of the same type are NOT equal.
+//
+//    assert.NotEqualT(t, obj1, obj2, "two objects shouldn't be equal")
+//
+// Returns whether the assertion was successful (true) or not (false).
+func NotEqualT[T comparable](t TestingT, expected, actual T, msgAndArgs ...interface{}) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+
+	if equalT(expected, actual) {
+		return Fail(t, "Should not be equal", msgAndArgs...)
+	}
+
+	return true
+}
+
+// ContainsT asserts that the list contains the element.
+//
+//    assert.ContainsT(t, []string{"Hello", "World"}, "World")
+//
+// Returns whether the assertion was successful (true) or not (false).
+func ContainsT[T comparable](t TestingT, list []T, element T, msgAndArgs ...interface{}) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+
+	for _, v := range list {
+		if equalT(v, element) {
+			return true
+		}
+	}
+
+	return Fail(t, fmt.Sprintf("%#v does not contain %#v", list, element), msgAndArgs...)
+}
+
+// ElementsOf asserts that actual has the same elements as expected, in the same
+// order.
+//
+//    assert.ElementsOf(t, []int{1, 2, 3}, ids)
+//
+// Returns whether the assertion was successful (true) or not (false).
+func ElementsOf[T comparable](t TestingT, expected, actual []T, msgAndArgs ...interface{}) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+
+	if len(expected) != len(actual) {
+		return Fail(t, equalFailureMessage(expected, actual), msgAndArgs...)
+	}
+
+	for i := range expected {
+		if !equalT(expected[i], actual[i]) {
+			return Fail(t, equalFailureMessage(expected, actual), msgAndArgs...)
+		}
+	}
+
+	return true
+}
+
+// Typed provides assertion methods against an 'actual' value of type T. The
+// Equal and NotEqual methods only accept values of type T, all other methods
+// are the same as those of Wrapped.
+type Typed[T comparable] struct {
+	Wrapped
+
+	// Must provides the same methods, but stops the test if an assertion fails.
+	// It is nil on the Must value itself.
+	Must *Typed[T]
+
+	actual T
+}
+
+// WrapT provides a function which will then allow you to assert properties of
+// the 'actual' value used, with the type of the values checked at compile time.
+//
+//    assert := assert.WrapT[int64](t)
+//    assert(count).Equal(3)
+func WrapT[T comparable](t TestingT) func(actual T) *Typed[T] {
+	wrap := Wrap(t)
+
+	return func(actual T) *Typed[T] {
+		wrapped := wrap(actual)
+
+		return &Typed[T]{
+			Wrapped: wrapped.Wrapped,
+			Must: &Typed[T]{
+				Wrapped: wrapped.Must,
+				actual:  actual,
+			},
+			actual: actual,
+		}
+	}
+}
+
+// Equal asserts that the 'actual' value is equal to expected.
+//
+//    assert(int64(123)).Equal(123)
+//
+// Returns whether the assertion was successful (true) or not (false).
+func (w *Typed[T]) Equal(expected T, msgAndArgs ...interface{}) bool {
+	if h, ok := w.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	return w.result(EqualT(w.t, expected, w.actual, msgAndArgs...), msgAndArgs...)
+}
+
+// NotEqual asserts that the 'actual' value is NOT equal to expected.
+//
+//    assert(obj2).NotEqual(obj1, "two objects shouldn't be equal")
+//
+// Returns whether the assertion was successful (true) or not (false).
+func (w *Typed[T]) NotEqual(expected T, msgAndArgs ...interface{}) bool {
+	if h, ok := w.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	return w.result(NotEqualT(w.t, expected, w.actual, msgAndArgs...), msgAndArgs...)
+}
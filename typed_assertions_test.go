@@ -0,0 +1,102 @@
+package assert
+
+import (
+	"testing"
+)
+
+func TestEqualT(t *testing.T) {
+	mockT := new(testing.T)
+
+	if !EqualT(mockT, int64(1), 1) {
+		t.Error("EqualT should return true")
+	}
+	if EqualT(mockT, "a", "b") {
+		t.Error("EqualT should return false")
+	}
+
+	bufT := new(bufferT)
+	EqualT(bufT, diffAddress{"1"}, diffAddress{"2"})
+	Contains(t, bufT.buf.String(), `.Zip: "1" != "2"`)
+}
+
+func TestNotEqualT(t *testing.T) {
+	mockT := new(testing.T)
+
+	if !NotEqualT(mockT, 1, 2) {
+		t.Error("NotEqualT should return true")
+	}
+	if NotEqualT(mockT, "a", "a") {
+		t.Error("NotEqualT should return false")
+	}
+}
+
+func TestContainsT(t *testing.T) {
+	mockT := new(testing.T)
+
+	if !ContainsT(mockT, []string{"a", "b"}, "b") {
+		t.Error("ContainsT should return true")
+	}
+	if ContainsT(mockT, []int{1, 2}, 3) {
+		t.Error("ContainsT should return false")
+	}
+}
+
+func TestTypedAssertionsWithInterfaces(t *testing.T) {
+	mockT := new(testing.T)
+
+	if !EqualT[any](mockT, []int{1}, []int{1}) {
+		t.Error("EqualT should compare uncomparable values in an interface")
+	}
+	if EqualT[any](mockT, []int{1}, []int{2}) || EqualT[any](mockT, []int{1}, 1) {
+		t.Error("EqualT should return false")
+	}
+	if NotEqualT[any](mockT, map[string]int{"a": 1}, map[string]int{"a": 1}) {
+		t.Error("NotEqualT should return false")
+	}
+	if !ContainsT[any](mockT, []any{1, []int{2}}, []int{2}) {
+		t.Error("ContainsT should return true")
+	}
+	if !ElementsOf[any](mockT, []any{[]int{1}}, []any{[]int{1}}) {
+		t.Error("ElementsOf should return true")
+	}
+}
+
+func TestElementsOf(t *testing.T) {
+	mockT := new(testing.T)
+
+	if !ElementsOf(mockT, []int{1, 2}, []int{1, 2}) {
+		t.Error("ElementsOf should return true")
+	}
+	if ElementsOf(mockT, []int{1, 2}, []int{2, 1}) {
+		t.Error("ElementsOf should return false")
+	}
+	if ElementsOf(mockT, []int{1, 2}, []int{1}) {
+		t.Error("ElementsOf should return false")
+	}
+}
+
+func TestWrapT(t *testing.T) {
+	mockT := new(failNowT)
+	assert := WrapT[int64](mockT)
+
+	if !assert(1).Equal(1) {
+		t.Error("Equal should return true")
+	}
+	if assert(1).Equal(2) {
+		t.Error("Equal should return false")
+	}
+	if !assert(1).NotEqual(2) {
+		t.Error("NotEqual should return true")
+	}
+	if !assert(1).NotNil() {
+		t.Error("NotNil should return true")
+	}
+	if mockT.failedNow {
+		t.Error("FailNow should not have been called")
+	}
+
+	assert(1).Must.Equal(2)
+	if !mockT.failedNow {
+		t.Error("Must.Equal should call FailNow")
+	}
+}
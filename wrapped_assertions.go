@@ -1,16 +1,11 @@
 package assert
 
 import (
-	"testing"
+	"fmt"
+	"reflect"
 	"time"
 )
 
-type wrappedLogf func(format string, args ...interface{})
-
-func (f wrappedLogf) Errorf(format string, args ...interface{}) {
-	f(format, args...)
-}
-
 // WrappedAssertions provides assertion methods against an 'actual' value. It
 // exposes the methods at on WrappedAssertions but also under the Must field:
 // which will ensure that if the assertion fails no more assertions will run for
@@ -23,35 +18,378 @@ type WrappedAssertions struct {
 // Wrapped provides assertion methods against an 'actual' value, reporting to
 // the wrapped 't'.
 type Wrapped struct {
-	t      wrappedLogf
-	actual interface{}
+	t       TestingT
+	failNow func()
+	actual  interface{}
+
+	// negate is set by Not, to invert the result of the next assertion.
+	negate bool
+
+	// path is the path navigated from the wrapped value to 'actual', such as
+	// ".Users[0]".
+	path string
+
+	// invalid is set when navigating to 'actual' failed, so every assertion
+	// against it fails without reporting anything more.
+	invalid bool
 }
 
 // Wrap provides a function which will then allow you to assert properties of
-// the 'actual' value used.
-func Wrap(t *testing.T) func(actual interface{}) *WrappedAssertions {
+// the 'actual' value used. It accepts any TestingT, so can be used with
+// *testing.T, *testing.B and *testing.F.
+//
+// The Must assertions call FailNow on 't' after a failure. If 't' does not
+// have a FailNow method the Must assertions only report the failure, in the
+// same way as the other assertions.
+func Wrap(t TestingT) WrapFunc {
+	var failNow func()
+	if f, ok := t.(failNower); ok {
+		failNow = f.FailNow
+	}
+
 	return func(actual interface{}) *WrappedAssertions {
 		return &WrappedAssertions{
 			Wrapped: Wrapped{
-				t:      wrappedLogf(t.Errorf),
+				t:      t,
 				actual: actual,
 			},
 			Must: Wrapped{
-				t:      wrappedLogf(t.Fatalf),
-				actual: actual,
+				t:       t,
+				failNow: failNow,
+				actual:  actual,
 			},
 		}
 	}
 }
 
+// WrapFunc is the function returned by Wrap, providing assertions against the
+// 'actual' value passed to it.
+type WrapFunc func(actual interface{}) *WrappedAssertions
+
+// WithContext returns a WrapFunc whose assertions include the label given by
+// format and args in the messages of their failures. Labels are nested when
+// WithContext is called again on the result.
+//
+//    assert := assert.Wrap(t).WithContext("case %d: %s", i, tc.name)
+//    assert(Parse(tc.input)).Equal(tc.expected)
+func (wrap WrapFunc) WithContext(format string, args ...interface{}) WrapFunc {
+	label := fmt.Sprintf(format, args...)
+
+	return func(actual interface{}) *WrappedAssertions {
+		wrapped := wrap(actual)
+		wrapped.Wrapped.t = WithContext(wrapped.Wrapped.t, "%s", label)
+		wrapped.Must.t = WithContext(wrapped.Must.t, "%s", label)
+
+		return wrapped
+	}
+}
+
+// defaultTick is how often the Comparison is polled by Eventually, Never and
+// Consistently.
+const defaultTick = 10 * time.Millisecond
+
+// comparison returns 'actual' as a Comparison, also accepting a plain
+// func() bool.
+func (w *Wrapped) comparison() (Comparison, bool) {
+	switch value := w.actual.(type) {
+	case Comparison:
+		return value, true
+	case func() bool:
+		return value, true
+	}
+
+	return nil, false
+}
+
+// error returns 'actual' as an error, which may be nil.
+func (w *Wrapped) error() (error, bool) {
+	if w.actual == nil {
+		return nil, true
+	}
+
+	err, ok := w.actual.(error)
+	return err, ok
+}
+
+// result stops the test if the assertion failed and these are Must
+// assertions, otherwise it returns whether the assertion was successful. When
+// the assertion was negated by Not the result is inverted first.
+func (w *Wrapped) result(success bool, msgAndArgs ...interface{}) bool {
+	if h, ok := w.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	if misused := w.takeMisuse(); w.invalid || misused {
+		success = false
+	} else if w.negate {
+		if success {
+			success = Fail(w.reportTo(), fmt.Sprintf("Not().%s passed for %#v, but should have failed", callerName(1), w.actual), msgAndArgs...)
+		} else {
+			success = true
+		}
+	}
+
+	if !success && w.failNow != nil {
+		w.failNow()
+	}
+
+	return success
+}
+
+// misuse fails because the method can't be used with 'actual', even if the
+// assertion was negated.
+func (w *Wrapped) misuse(failureMessage string) bool {
+	if h, ok := w.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	if !w.invalid {
+		Fail(w.reportTo(), failureMessage)
+	}
+	if w.failNow != nil {
+		w.failNow()
+	}
+
+	return false
+}
+
+// takeMisuse returns whether the last assertion failed through failMisuse while
+// negated, so has already been reported, clearing the record of it.
+func (w *Wrapped) takeMisuse() bool {
+	r, ok := reporterOf(w.t)
+	if !ok || !r.misused {
+		return false
+	}
+
+	r.misused = false
+	return true
+}
+
+// reportTo returns the TestingT that failures are reported to, even when the
+// failures of negated assertions are being discarded.
+func (w *Wrapped) reportTo() TestingT {
+	return withReporter(w.t, func(r *reporter) {
+		r.discard = false
+	})
+}
+
+// Not inverts the next assertion, so that it passes when it would have failed
+// and fails when it would have passed.
+//
+//    assert("it's starting").Not().Regexp("^it's not")
+//    assert(resp).Not().Field("Error").NotNil()
+func (w *Wrapped) Not() *Wrapped {
+	n := *w
+	n.negate = !w.negate
+	n.t = withReporter(w.t, func(r *reporter) {
+		r.discard = n.negate
+	})
+
+	return &n
+}
+
+// navigate returns assertions against actual, a value reached from 'actual'
+// by step.
+func (w *Wrapped) navigate(step string, actual interface{}) *Wrapped {
+	n := *w
+	n.actual = actual
+	n.path = w.path + step
+	if n.path != "" {
+		n.t = withReporter(w.t, func(r *reporter) {
+			r.setNote("Path", n.path)
+		})
+	}
+
+	return &n
+}
+
+// cannotNavigate reports that step could not be taken from 'actual', returning
+// assertions that will all fail.
+func (w *Wrapped) cannotNavigate(step, failureMessage string) *Wrapped {
+	if h, ok := w.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	if !w.invalid {
+		Fail(w.reportTo(), failureMessage)
+	}
+	if w.failNow != nil {
+		w.failNow()
+	}
+
+	n := w.navigate(step, nil)
+	n.invalid = true
+	n.t = withReporter(n.t, func(r *reporter) {
+		r.discard = true
+	})
+
+	return n
+}
+
+// Field returns assertions against the named field of the 'actual' struct, or
+// pointer to a struct. Failures against the field include the path to it.
+//
+//    assert(resp).Field("Status").Equal(200)
+func (w *Wrapped) Field(name string) *Wrapped {
+	if h, ok := w.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	step := "." + name
+	value := indirect(reflect.ValueOf(w.actual))
+	if isNilValue(value) {
+		return w.cannotNavigate(step, fmt.Sprintf("Field %s called against a nil %T", name, w.actual))
+	}
+	if value.Kind() != reflect.Struct {
+		return w.cannotNavigate(step, fmt.Sprintf("Field %s called against %#v, which is not a struct", name, w.actual))
+	}
+
+	field := value.FieldByName(name)
+	if !field.IsValid() {
+		return w.cannotNavigate(step, fmt.Sprintf("%T has no field %s", w.actual, name))
+	}
+	if !field.CanInterface() {
+		return w.cannotNavigate(step, fmt.Sprintf("Field %s of %T is unexported", name, w.actual))
+	}
+
+	return w.navigate(step, field.Interface())
+}
+
+// Index returns assertions against the element at index i of the 'actual'
+// array, slice or string, or pointer to one.
+//
+//    assert(resp).Field("Items").Index(0).Field("Name").Equal("John")
+func (w *Wrapped) Index(i int) *Wrapped {
+	if h, ok := w.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	step := fmt.Sprintf("[%d]", i)
+	value := indirect(reflect.ValueOf(w.actual))
+	if isNilValue(value) {
+		return w.cannotNavigate(step, fmt.Sprintf("Index %d called against a nil %T", i, w.actual))
+	}
+
+	switch value.Kind() {
+	case reflect.Array, reflect.Slice, reflect.String:
+	default:
+		return w.cannotNavigate(step, fmt.Sprintf("Index %d called against %#v, which is not an array, slice or string", i, w.actual))
+	}
+
+	if i < 0 || i >= value.Len() {
+		return w.cannotNavigate(step, fmt.Sprintf("Index %d out of range for %T with length %d", i, w.actual, value.Len()))
+	}
+
+	return w.navigate(step, value.Index(i).Interface())
+}
+
+// Key returns assertions against the value stored under key in the 'actual'
+// map, or pointer to a map.
+//
+//    assert(headers).Key("Content-Type").Equal("application/json")
+func (w *Wrapped) Key(key interface{}) *Wrapped {
+	if h, ok := w.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	step := fmt.Sprintf("[%#v]", key)
+	value := indirect(reflect.ValueOf(w.actual))
+	if isNilValue(value) {
+		return w.cannotNavigate(step, fmt.Sprintf("Key %#v called against a nil %T", key, w.actual))
+	}
+	if value.Kind() != reflect.Map {
+		return w.cannotNavigate(step, fmt.Sprintf("Key %#v called against %#v, which is not a map", key, w.actual))
+	}
+
+	element, ok := mapIndex(value, reflect.ValueOf(key))
+	if !ok {
+		return w.cannotNavigate(step, fmt.Sprintf("%T has no key %#v", w.actual, key))
+	}
+
+	return w.navigate(step, element.Interface())
+}
+
+// Deref returns assertions against the value that the 'actual' pointer points
+// to.
+//
+//    assert(resp).Field("Body").Deref().Equal(Body{Name: "John"})
+func (w *Wrapped) Deref() *Wrapped {
+	if h, ok := w.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	value := reflect.ValueOf(w.actual)
+	if value.Kind() != reflect.Ptr {
+		return w.cannotNavigate("", fmt.Sprintf("Deref called against %#v, which is not a pointer", w.actual))
+	}
+	if value.IsNil() {
+		return w.cannotNavigate("", fmt.Sprintf("Deref called against a nil %T", w.actual))
+	}
+
+	return w.navigate("", value.Elem().Interface())
+}
+
+// Call returns assertions against the result of calling the named method of
+// the 'actual' value with args. The method must return a single value, or a
+// value and an error; if the error is not nil the call fails.
+//
+//    assert(resp).Call("Header", "Content-Type").Equal("application/json")
+func (w *Wrapped) Call(method string, args ...interface{}) *Wrapped {
+	if h, ok := w.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	step := "." + method + "(" + formatArgs(args) + ")"
+	value := reflect.ValueOf(w.actual)
+	if !value.IsValid() {
+		return w.cannotNavigate(step, fmt.Sprintf("Call %s called against nil", method))
+	}
+
+	fn := value.MethodByName(method)
+	if !fn.IsValid() && value.Kind() != reflect.Ptr {
+		// methods with a pointer receiver can be called against a copy
+		ptr := reflect.New(value.Type())
+		ptr.Elem().Set(value)
+		fn = ptr.MethodByName(method)
+	}
+	if !fn.IsValid() {
+		return w.cannotNavigate(step, fmt.Sprintf("%T has no method %s", w.actual, method))
+	}
+	if value.Kind() == reflect.Ptr && value.IsNil() {
+		return w.cannotNavigate(step, fmt.Sprintf("Call %s called against a nil %T", method, w.actual))
+	}
+
+	in, err := callArgs(fn.Type(), args)
+	if err != nil {
+		return w.cannotNavigate(step, fmt.Sprintf("Cannot call %s: %v", method, err))
+	}
+
+	fnType := fn.Type()
+	returnsError := fnType.NumOut() == 2 && fnType.Out(1) == errorType
+	if fnType.NumOut() != 1 && !returnsError {
+		return w.cannotNavigate(step, fmt.Sprintf("Cannot call %s: it must return a value, or a value and an error", method))
+	}
+
+	out := fn.Call(in)
+	if returnsError && !out[1].IsNil() {
+		return w.cannotNavigate(step, fmt.Sprintf("%s returned error: %v", method, out[1].Interface()))
+	}
+
+	return w.navigate(step, out[0].Interface())
+}
+
 // Fail marks the test as a failure, using the 'actual' value as the failure message.
 //
 //  assert := assert.Wrap(t)
 //  assert("the test failed").Fail()
 //
 func (w *Wrapped) Fail(msgAndArgs ...interface{}) bool {
+	if h, ok := w.t.(tHelper); ok {
+		h.Helper()
+	}
+
 	value, _ := w.actual.(string)
-	return Fail(w.t, value, msgAndArgs...)
+	return w.result(Fail(w.t, value, msgAndArgs...), msgAndArgs...)
 }
 
 // Condition uses the Comparison provided to 'actual' to assert a complex condition.
@@ -60,12 +398,35 @@ func (w *Wrapped) Fail(msgAndArgs ...interface{}) bool {
 //   assert(func() bool { return true  }).Condition()
 //
 func (w *Wrapped) Condition(msgAndArgs ...interface{}) bool {
-	value, ok := w.actual.(Comparison)
+	if h, ok := w.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	value, ok := w.comparison()
 	if !ok {
-		return Fail(w.t, "Condition called against a non-Comparison")
+		return w.misuse("Condition called against a non-Comparison")
 	}
 
-	return Condition(w.t, value, msgAndArgs...)
+	return w.result(Condition(w.t, value, msgAndArgs...), msgAndArgs...)
+}
+
+// Consistently asserts that the Comparison provided to 'actual' returns true
+// every time it is polled, for the whole of waitFor.
+//
+//   assert(func() bool { return conn.Open() }).Consistently(time.Second)
+//
+// Returns whether the assertion was successful (true) or not (false).
+func (w *Wrapped) Consistently(waitFor time.Duration, msgAndArgs ...interface{}) bool {
+	if h, ok := w.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	value, ok := w.comparison()
+	if !ok {
+		return w.misuse("Consistently called against a non-Comparison")
+	}
+
+	return w.result(Consistently(w.t, value, waitFor, defaultTick, msgAndArgs...), msgAndArgs...)
 }
 
 // Contains asserts that the specified string contains the specified substring.
@@ -74,7 +435,25 @@ func (w *Wrapped) Condition(msgAndArgs ...interface{}) bool {
 //
 // Returns whether the assertion was successful (true) or not (false).
 func (w *Wrapped) Contains(expected interface{}, msgAndArgs ...interface{}) bool {
-	return Contains(w.t, w.actual, expected, msgAndArgs...)
+	if h, ok := w.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	return w.result(Contains(w.t, w.actual, expected, msgAndArgs...), msgAndArgs...)
+}
+
+// ElementsMatch asserts that the 'actual' list contains the same elements as
+// the expected list, ignoring their order.
+//
+//    assert([]int{3, 1, 2}).ElementsMatch([]int{1, 2, 3})
+//
+// Returns whether the assertion was successful (true) or not (false).
+func (w *Wrapped) ElementsMatch(expected interface{}, msgAndArgs ...interface{}) bool {
+	if h, ok := w.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	return w.result(ElementsMatch(w.t, expected, w.actual, msgAndArgs...), msgAndArgs...)
 }
 
 // Empty asserts that the specified object is empty: i.e. nil, "", false, 0 or a
@@ -84,7 +463,11 @@ func (w *Wrapped) Contains(expected interface{}, msgAndArgs ...interface{}) bool
 //
 // Returns whether the assertion was successful (true) or not (false).
 func (w *Wrapped) Empty(msgAndArgs ...interface{}) bool {
-	return Empty(w.t, w.actual, msgAndArgs...)
+	if h, ok := w.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	return w.result(Empty(w.t, w.actual, msgAndArgs...), msgAndArgs...)
 }
 
 // Equal asserts that two objects are equal.
@@ -93,7 +476,46 @@ func (w *Wrapped) Empty(msgAndArgs ...interface{}) bool {
 //
 // Returns whether the assertion was successful (true) or not (false).
 func (w *Wrapped) Equal(expected interface{}, msgAndArgs ...interface{}) bool {
-	return Equal(w.t, expected, w.actual, msgAndArgs...)
+	if h, ok := w.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	return w.result(Equal(w.t, expected, w.actual, msgAndArgs...), msgAndArgs...)
+}
+
+// EqualError asserts that the error is not nil and that it is equal to the
+// provided error message.
+//
+//    assert(err).EqualError("file not found")
+//
+// Returns whether the assertion was successful (true) or not (false).
+func (w *Wrapped) EqualError(errString string, msgAndArgs ...interface{}) bool {
+	if h, ok := w.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	err, ok := w.error()
+	if !ok {
+		return w.misuse("EqualError called against a non-error")
+	}
+
+	return w.result(EqualError(w.t, err, errString, msgAndArgs...), msgAndArgs...)
+}
+
+// EqualWith asserts that two objects are equal, using the EqualOption values in
+// msgAndArgs to control how they are compared. The other values in msgAndArgs
+// are used as the message, as for any other assertion.
+//
+//    assert(actual).EqualWith(expected, assert.IgnoreFields("CreatedAt"))
+//
+// Returns whether the assertion was successful (true) or not (false).
+func (w *Wrapped) EqualWith(expected interface{}, msgAndArgs ...interface{}) bool {
+	if h, ok := w.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	_, message := splitEqualOptions(msgAndArgs)
+	return w.result(EqualWith(w.t, expected, w.actual, msgAndArgs...), message...)
 }
 
 // Equivalent asserts that two objects are equal or convertable to the same types
@@ -103,7 +525,127 @@ func (w *Wrapped) Equal(expected interface{}, msgAndArgs ...interface{}) bool {
 //
 // Returns whether the assertion was successful (true) or not (false).
 func (w *Wrapped) Equivalent(expected interface{}, msgAndArgs ...interface{}) bool {
-	return Equivalent(w.t, expected, w.actual, msgAndArgs...)
+	if h, ok := w.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	return w.result(Equivalent(w.t, expected, w.actual, msgAndArgs...), msgAndArgs...)
+}
+
+// Error asserts that the error is not nil.
+//
+//    assert(err).Error("An error was expected")
+//
+// Returns whether the assertion was successful (true) or not (false).
+func (w *Wrapped) Error(msgAndArgs ...interface{}) bool {
+	if h, ok := w.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	err, ok := w.error()
+	if !ok {
+		return w.misuse("Error called against a non-error")
+	}
+
+	return w.result(Error(w.t, err, msgAndArgs...), msgAndArgs...)
+}
+
+// ErrorAs asserts that at least one of the errors in the chain matches target,
+// using errors.As, and if so sets target to that error.
+//
+//    var pathErr *fs.PathError
+//    assert(err).ErrorAs(&pathErr)
+//
+// Returns whether the assertion was successful (true) or not (false).
+func (w *Wrapped) ErrorAs(target interface{}, msgAndArgs ...interface{}) bool {
+	if h, ok := w.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	err, ok := w.error()
+	if !ok {
+		return w.misuse("ErrorAs called against a non-error")
+	}
+
+	return w.result(ErrorAs(w.t, err, target, msgAndArgs...), msgAndArgs...)
+}
+
+// ErrorContains asserts that the error is not nil and that its message
+// contains the specified substring.
+//
+//    assert(err).ErrorContains("not found")
+//
+// Returns whether the assertion was successful (true) or not (false).
+func (w *Wrapped) ErrorContains(contains string, msgAndArgs ...interface{}) bool {
+	if h, ok := w.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	err, ok := w.error()
+	if !ok {
+		return w.misuse("ErrorContains called against a non-error")
+	}
+
+	return w.result(ErrorContains(w.t, err, contains, msgAndArgs...), msgAndArgs...)
+}
+
+// ErrorIs asserts that at least one of the errors in the chain matches target,
+// using errors.Is.
+//
+//    assert(err).ErrorIs(fs.ErrNotExist)
+//
+// Returns whether the assertion was successful (true) or not (false).
+func (w *Wrapped) ErrorIs(target error, msgAndArgs ...interface{}) bool {
+	if h, ok := w.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	err, ok := w.error()
+	if !ok {
+		return w.misuse("ErrorIs called against a non-error")
+	}
+
+	return w.result(ErrorIs(w.t, err, target, msgAndArgs...), msgAndArgs...)
+}
+
+// Eventually asserts that the Comparison provided to 'actual' returns true
+// within waitFor, polling it regularly.
+//
+//   assert(func() bool { return cache.Len() == 3 }).Eventually(5*time.Second)
+//
+// Returns whether the assertion was successful (true) or not (false).
+func (w *Wrapped) Eventually(waitFor time.Duration, msgAndArgs ...interface{}) bool {
+	if h, ok := w.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	value, ok := w.comparison()
+	if !ok {
+		return w.misuse("Eventually called against a non-Comparison")
+	}
+
+	return w.result(Eventually(w.t, value, waitFor, defaultTick, msgAndArgs...), msgAndArgs...)
+}
+
+// EventuallyWithT asserts that all of the assertions made in the func(*CollectT)
+// provided to 'actual' pass within waitFor, polling it regularly.
+//
+//   assert(func(c *assert.CollectT) {
+//     assert.Equal(c, 3, cache.Len())
+//   }).EventuallyWithT(5*time.Second)
+//
+// Returns whether the assertion was successful (true) or not (false).
+func (w *Wrapped) EventuallyWithT(waitFor time.Duration, msgAndArgs ...interface{}) bool {
+	if h, ok := w.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	value, ok := w.actual.(func(c *CollectT))
+	if !ok {
+		return w.misuse("EventuallyWithT called against a non-func(*CollectT)")
+	}
+
+	return w.result(EventuallyWithT(w.t, value, waitFor, defaultTick, msgAndArgs...), msgAndArgs...)
 }
 
 // Exactly asserts that two objects are equal is value and type.
@@ -112,7 +654,11 @@ func (w *Wrapped) Equivalent(expected interface{}, msgAndArgs ...interface{}) bo
 //
 // Returns whether the assertion was successful (true) or not (false).
 func (w *Wrapped) Exactly(expected interface{}, msgAndArgs ...interface{}) bool {
-	return Exactly(w.t, expected, w.actual, msgAndArgs...)
+	if h, ok := w.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	return w.result(Exactly(w.t, expected, w.actual, msgAndArgs...), msgAndArgs...)
 }
 
 // False asserts that the specified value is true.
@@ -121,12 +667,69 @@ func (w *Wrapped) Exactly(expected interface{}, msgAndArgs ...interface{}) bool
 //
 // Returns whether the assertion was successful (true) or not (false).
 func (w *Wrapped) False(msgAndArgs ...interface{}) bool {
+	if h, ok := w.t.(tHelper); ok {
+		h.Helper()
+	}
+
 	value, ok := w.actual.(bool)
 	if !ok {
-		return Fail(w.t, "False called against a non-bool")
+		return w.misuse("False called against a non-bool")
+	}
+
+	return w.result(False(w.t, value, msgAndArgs...), msgAndArgs...)
+}
+
+// Greater asserts that the 'actual' value is greater than e2.
+//
+//    assert(2).Greater(1)
+//
+// Returns whether the assertion was successful (true) or not (false).
+func (w *Wrapped) Greater(e2 interface{}, msgAndArgs ...interface{}) bool {
+	if h, ok := w.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	return w.result(Greater(w.t, w.actual, e2, msgAndArgs...), msgAndArgs...)
+}
+
+// GreaterOrEqual asserts that the 'actual' value is greater than or equal to e2.
+//
+//    assert(2).GreaterOrEqual(2)
+//
+// Returns whether the assertion was successful (true) or not (false).
+func (w *Wrapped) GreaterOrEqual(e2 interface{}, msgAndArgs ...interface{}) bool {
+	if h, ok := w.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	return w.result(GreaterOrEqual(w.t, w.actual, e2, msgAndArgs...), msgAndArgs...)
+}
+
+// HasEntry asserts that the 'actual' map contains the key, and that its value is
+// equal to the expected value.
+//
+//    assert(map[string]int{"a": 1}).HasEntry("a", 1)
+//
+// Returns whether the assertion was successful (true) or not (false).
+func (w *Wrapped) HasEntry(key, expected interface{}, msgAndArgs ...interface{}) bool {
+	if h, ok := w.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	return w.result(HasEntry(w.t, w.actual, key, expected, msgAndArgs...), msgAndArgs...)
+}
+
+// HasKey asserts that the 'actual' map contains the key.
+//
+//    assert(map[string]int{"a": 1}).HasKey("a")
+//
+// Returns whether the assertion was successful (true) or not (false).
+func (w *Wrapped) HasKey(key interface{}, msgAndArgs ...interface{}) bool {
+	if h, ok := w.t.(tHelper); ok {
+		h.Helper()
 	}
 
-	return False(w.t, value, msgAndArgs...)
+	return w.result(HasKey(w.t, w.actual, key, msgAndArgs...), msgAndArgs...)
 }
 
 // Implements asserts that an object is implemented by the specified interface.
@@ -135,7 +738,11 @@ func (w *Wrapped) False(msgAndArgs ...interface{}) bool {
 //
 // Returns whether the assertion was successful (true) or not (false).
 func (w *Wrapped) Implements(iface interface{}, msgAndArgs ...interface{}) bool {
-	return Implements(w.t, iface, w.actual, msgAndArgs...)
+	if h, ok := w.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	return w.result(Implements(w.t, iface, w.actual, msgAndArgs...), msgAndArgs...)
 }
 
 // InDelta asserts that the two numerals are within delta of each other.
@@ -144,7 +751,11 @@ func (w *Wrapped) Implements(iface interface{}, msgAndArgs ...interface{}) bool
 //
 // Returns whether the assertion was successful (true) or not (false).
 func (w *Wrapped) InDelta(expected interface{}, delta float64, msgAndArgs ...interface{}) bool {
-	return InDelta(w.t, expected, w.actual, delta, msgAndArgs...)
+	if h, ok := w.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	return w.result(InDelta(w.t, expected, w.actual, delta, msgAndArgs...), msgAndArgs...)
 }
 
 // InEpsilon asserts that expected and actual have a relative error less than
@@ -152,14 +763,90 @@ func (w *Wrapped) InDelta(expected interface{}, delta float64, msgAndArgs ...int
 //
 // Returns whether the assertion was successful (true) or not (false).
 func (w *Wrapped) InEpsilon(expected interface{}, epsilon float64, msgAndArgs ...interface{}) bool {
-	return InEpsilon(w.t, expected, w.actual, epsilon, msgAndArgs...)
+	if h, ok := w.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	return w.result(InEpsilon(w.t, expected, w.actual, epsilon, msgAndArgs...), msgAndArgs...)
+}
+
+// IsDecreasing asserts that each element of the 'actual' list is less than the
+// one before it.
+//
+//    assert([]int{3, 2, 1}).IsDecreasing()
+//
+// Returns whether the assertion was successful (true) or not (false).
+func (w *Wrapped) IsDecreasing(msgAndArgs ...interface{}) bool {
+	if h, ok := w.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	return w.result(IsDecreasing(w.t, w.actual, msgAndArgs...), msgAndArgs...)
+}
+
+// IsIncreasing asserts that each element of the 'actual' list is greater than
+// the one before it.
+//
+//    assert(eventIDs).IsIncreasing()
+//
+// Returns whether the assertion was successful (true) or not (false).
+func (w *Wrapped) IsIncreasing(msgAndArgs ...interface{}) bool {
+	if h, ok := w.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	return w.result(IsIncreasing(w.t, w.actual, msgAndArgs...), msgAndArgs...)
+}
+
+// IsNonDecreasing asserts that each element of the 'actual' list is greater than
+// or equal to the one before it.
+//
+//    assert([]int{1, 1, 2}).IsNonDecreasing()
+//
+// Returns whether the assertion was successful (true) or not (false).
+func (w *Wrapped) IsNonDecreasing(msgAndArgs ...interface{}) bool {
+	if h, ok := w.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	return w.result(IsNonDecreasing(w.t, w.actual, msgAndArgs...), msgAndArgs...)
+}
+
+// IsSorted asserts that the 'actual' list is sorted in ascending order.
+//
+//    assert([]string{"a", "b", "b"}).IsSorted()
+//
+// Returns whether the assertion was successful (true) or not (false).
+func (w *Wrapped) IsSorted(msgAndArgs ...interface{}) bool {
+	if h, ok := w.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	return w.result(IsSorted(w.t, w.actual, msgAndArgs...), msgAndArgs...)
+}
+
+// IsSortedBy asserts that the 'actual' list is sorted according to less.
+//
+//    assert(events).IsSortedBy(func(a, b Event) bool { return a.At.Before(b.At) })
+//
+// Returns whether the assertion was successful (true) or not (false).
+func (w *Wrapped) IsSortedBy(less interface{}, msgAndArgs ...interface{}) bool {
+	if h, ok := w.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	return w.result(IsSortedBy(w.t, w.actual, less, msgAndArgs...), msgAndArgs...)
 }
 
 // IsType asserts that the specified objects are of the same type.
 //
 // Returns whether the assertion was successful (true) or not (false).
 func (w *Wrapped) IsType(expected interface{}, msgAndArgs ...interface{}) bool {
-	return IsType(w.t, expected, w.actual, msgAndArgs...)
+	if h, ok := w.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	return w.result(IsType(w.t, expected, w.actual, msgAndArgs...), msgAndArgs...)
 }
 
 // Len asserts that the specified object has specific length.
@@ -169,7 +856,82 @@ func (w *Wrapped) IsType(expected interface{}, msgAndArgs ...interface{}) bool {
 //
 // Returns whether the assertion was successful (true) or not (false).
 func (w *Wrapped) Len(length int, msgAndArgs ...interface{}) bool {
-	return Len(w.t, w.actual, length, msgAndArgs...)
+	if h, ok := w.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	return w.result(Len(w.t, w.actual, length, msgAndArgs...), msgAndArgs...)
+}
+
+// Less asserts that the 'actual' value is less than e2.
+//
+//    assert(1).Less(2)
+//
+// Returns whether the assertion was successful (true) or not (false).
+func (w *Wrapped) Less(e2 interface{}, msgAndArgs ...interface{}) bool {
+	if h, ok := w.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	return w.result(Less(w.t, w.actual, e2, msgAndArgs...), msgAndArgs...)
+}
+
+// LessOrEqual asserts that the 'actual' value is less than or equal to e2.
+//
+//    assert(2).LessOrEqual(2)
+//
+// Returns whether the assertion was successful (true) or not (false).
+func (w *Wrapped) LessOrEqual(e2 interface{}, msgAndArgs ...interface{}) bool {
+	if h, ok := w.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	return w.result(LessOrEqual(w.t, w.actual, e2, msgAndArgs...), msgAndArgs...)
+}
+
+// Matches asserts that the 'actual' value matches the Matcher.
+//
+//    assert(events).Matches(assert.Each(assert.HasField("Status", "ok")))
+//
+// Returns whether the assertion was successful (true) or not (false).
+func (w *Wrapped) Matches(matcher Matcher, msgAndArgs ...interface{}) bool {
+	if h, ok := w.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	return w.result(That(w.t, w.actual, matcher, msgAndArgs...), msgAndArgs...)
+}
+
+// Negative asserts that the 'actual' value is less than zero.
+//
+//    assert(-1).Negative()
+//
+// Returns whether the assertion was successful (true) or not (false).
+func (w *Wrapped) Negative(msgAndArgs ...interface{}) bool {
+	if h, ok := w.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	return w.result(Negative(w.t, w.actual, msgAndArgs...), msgAndArgs...)
+}
+
+// Never asserts that the Comparison provided to 'actual' does not return true
+// within waitFor, polling it regularly.
+//
+//   assert(func() bool { return worker.Crashed() }).Never(time.Second)
+//
+// Returns whether the assertion was successful (true) or not (false).
+func (w *Wrapped) Never(waitFor time.Duration, msgAndArgs ...interface{}) bool {
+	if h, ok := w.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	value, ok := w.comparison()
+	if !ok {
+		return w.misuse("Never called against a non-Comparison")
+	}
+
+	return w.result(Never(w.t, value, waitFor, defaultTick, msgAndArgs...), msgAndArgs...)
 }
 
 // Nil asserts that the specified object is nil.
@@ -178,7 +940,29 @@ func (w *Wrapped) Len(length int, msgAndArgs ...interface{}) bool {
 //
 // Returns whether the assertion was successful (true) or not (false).
 func (w *Wrapped) Nil(msgAndArgs ...interface{}) bool {
-	return Nil(w.t, w.actual, msgAndArgs...)
+	if h, ok := w.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	return w.result(Nil(w.t, w.actual, msgAndArgs...), msgAndArgs...)
+}
+
+// NoError asserts that the error is nil.
+//
+//    assert(err).NoError()
+//
+// Returns whether the assertion was successful (true) or not (false).
+func (w *Wrapped) NoError(msgAndArgs ...interface{}) bool {
+	if h, ok := w.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	err, ok := w.error()
+	if !ok {
+		return w.misuse("NoError called against a non-error")
+	}
+
+	return w.result(NoError(w.t, err, msgAndArgs...), msgAndArgs...)
 }
 
 // NotContains asserts that the specified string does NOT contain the specified substring.
@@ -187,7 +971,11 @@ func (w *Wrapped) Nil(msgAndArgs ...interface{}) bool {
 //
 // Returns whether the assertion was successful (true) or not (false).
 func (w *Wrapped) NotContains(expected interface{}, msgAndArgs ...interface{}) bool {
-	return NotContains(w.t, w.actual, expected, msgAndArgs...)
+	if h, ok := w.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	return w.result(NotContains(w.t, w.actual, expected, msgAndArgs...), msgAndArgs...)
 }
 
 // NotEmpty asserts that the specified object is NOT empty: i.e. not nil, "",
@@ -199,7 +987,11 @@ func (w *Wrapped) NotContains(expected interface{}, msgAndArgs ...interface{}) b
 //
 // Returns whether the assertion was successful (true) or not (false).
 func (w *Wrapped) NotEmpty(msgAndArgs ...interface{}) bool {
-	return NotEmpty(w.t, w.actual, msgAndArgs...)
+	if h, ok := w.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	return w.result(NotEmpty(w.t, w.actual, msgAndArgs...), msgAndArgs...)
 }
 
 // NotEqual asserts that the specified values are NOT equal.
@@ -208,7 +1000,43 @@ func (w *Wrapped) NotEmpty(msgAndArgs ...interface{}) bool {
 //
 // Returns whether the assertion was successful (true) or not (false).
 func (w *Wrapped) NotEqual(expected interface{}, msgAndArgs ...interface{}) bool {
-	return NotEqual(w.t, expected, w.actual, msgAndArgs...)
+	if h, ok := w.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	return w.result(NotEqual(w.t, expected, w.actual, msgAndArgs...), msgAndArgs...)
+}
+
+// NotErrorIs asserts that none of the errors in the chain match target, using
+// errors.Is.
+//
+//    assert(err).NotErrorIs(fs.ErrNotExist)
+//
+// Returns whether the assertion was successful (true) or not (false).
+func (w *Wrapped) NotErrorIs(target error, msgAndArgs ...interface{}) bool {
+	if h, ok := w.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	err, ok := w.error()
+	if !ok {
+		return w.misuse("NotErrorIs called against a non-error")
+	}
+
+	return w.result(NotErrorIs(w.t, err, target, msgAndArgs...), msgAndArgs...)
+}
+
+// NotHasKey asserts that the 'actual' map does NOT contain the key.
+//
+//    assert(map[string]int{"a": 1}).NotHasKey("b")
+//
+// Returns whether the assertion was successful (true) or not (false).
+func (w *Wrapped) NotHasKey(key interface{}, msgAndArgs ...interface{}) bool {
+	if h, ok := w.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	return w.result(NotHasKey(w.t, w.actual, key, msgAndArgs...), msgAndArgs...)
 }
 
 // NotNil asserts that the specified object is not nil.
@@ -217,7 +1045,11 @@ func (w *Wrapped) NotEqual(expected interface{}, msgAndArgs ...interface{}) bool
 //
 // Returns whether the assertion was successful (true) or not (false).
 func (w *Wrapped) NotNil(msgAndArgs ...interface{}) bool {
-	return NotNil(w.t, w.actual, msgAndArgs...)
+	if h, ok := w.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	return w.result(NotNil(w.t, w.actual, msgAndArgs...), msgAndArgs...)
 }
 
 // NotPanics asserts that the code inside the specified func does NOT panic.
@@ -226,12 +1058,16 @@ func (w *Wrapped) NotNil(msgAndArgs ...interface{}) bool {
 //
 // Returns whether the assertion was successful (true) or not (false).
 func (w *Wrapped) NotPanics(msgAndArgs ...interface{}) bool {
+	if h, ok := w.t.(tHelper); ok {
+		h.Helper()
+	}
+
 	value, ok := w.actual.(func())
 	if !ok {
-		return Fail(w.t, "NotPanics called against a non-func() ")
+		return w.misuse("NotPanics called against a non-func() ")
 	}
 
-	return NotPanics(w.t, value, msgAndArgs...)
+	return w.result(NotPanics(w.t, value, msgAndArgs...), msgAndArgs...)
 }
 
 // NotRegexp asserts that a specified regexp does not match a string.
@@ -241,7 +1077,38 @@ func (w *Wrapped) NotPanics(msgAndArgs ...interface{}) bool {
 //
 // Returns whether the assertion was successful (true) or not (false).
 func (w *Wrapped) NotRegexp(regex interface{}, msgAndArgs ...interface{}) bool {
-	return NotRegexp(w.t, regex, w.actual, msgAndArgs...)
+	if h, ok := w.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	return w.result(NotRegexp(w.t, regex, w.actual, msgAndArgs...), msgAndArgs...)
+}
+
+// NotSubset asserts that the 'actual' list or map does NOT contain all of the
+// elements or entries of subset.
+//
+//    assert([]int{1, 2, 3}).NotSubset([]int{1, 4})
+//
+// Returns whether the assertion was successful (true) or not (false).
+func (w *Wrapped) NotSubset(subset interface{}, msgAndArgs ...interface{}) bool {
+	if h, ok := w.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	return w.result(NotSubset(w.t, w.actual, subset, msgAndArgs...), msgAndArgs...)
+}
+
+// NotZero asserts that the 'actual' value is not zero.
+//
+//    assert(elapsed).NotZero()
+//
+// Returns whether the assertion was successful (true) or not (false).
+func (w *Wrapped) NotZero(msgAndArgs ...interface{}) bool {
+	if h, ok := w.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	return w.result(NotZero(w.t, w.actual, msgAndArgs...), msgAndArgs...)
 }
 
 // Panics asserts that the code inside the specified func panics.
@@ -250,12 +1117,87 @@ func (w *Wrapped) NotRegexp(regex interface{}, msgAndArgs ...interface{}) bool {
 //
 // Returns whether the assertion was successful (true) or not (false).
 func (w *Wrapped) Panics(msgAndArgs ...interface{}) bool {
+	if h, ok := w.t.(tHelper); ok {
+		h.Helper()
+	}
+
 	value, ok := w.actual.(func())
 	if !ok {
-		return Fail(w.t, "Panics called against a non-func() ")
+		return w.misuse("Panics called against a non-func() ")
 	}
 
-	return Panics(w.t, value, msgAndArgs...)
+	return w.result(Panics(w.t, value, msgAndArgs...), msgAndArgs...)
+}
+
+// PanicsMatching asserts that the code inside the specified func panics, and
+// that the recovered panic value matches the regexp.
+//
+//   assert(func(){ GoCrazy() }).PanicsMatching("index out of range")
+//
+// Returns whether the assertion was successful (true) or not (false).
+func (w *Wrapped) PanicsMatching(rx interface{}, msgAndArgs ...interface{}) bool {
+	if h, ok := w.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	value, ok := w.actual.(func())
+	if !ok {
+		return w.misuse("PanicsMatching called against a non-func() ")
+	}
+
+	return w.result(PanicsMatching(w.t, rx, value, msgAndArgs...), msgAndArgs...)
+}
+
+// PanicsWithError asserts that the code inside the specified func panics with an
+// error matching expected, either using errors.Is or by its message.
+//
+//   assert(func(){ GoCrazy() }).PanicsWithError(ErrCrazy)
+//   assert(func(){ GoCrazy() }).PanicsWithError("crazy error")
+//
+// Returns whether the assertion was successful (true) or not (false).
+func (w *Wrapped) PanicsWithError(expected interface{}, msgAndArgs ...interface{}) bool {
+	if h, ok := w.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	value, ok := w.actual.(func())
+	if !ok {
+		return w.misuse("PanicsWithError called against a non-func() ")
+	}
+
+	return w.result(PanicsWithError(w.t, expected, value, msgAndArgs...), msgAndArgs...)
+}
+
+// PanicsWithValue asserts that the code inside the specified func panics, and
+// that the recovered panic value equals the expected value.
+//
+//   assert(func(){ GoCrazy() }).PanicsWithValue("crazy error")
+//
+// Returns whether the assertion was successful (true) or not (false).
+func (w *Wrapped) PanicsWithValue(expected interface{}, msgAndArgs ...interface{}) bool {
+	if h, ok := w.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	value, ok := w.actual.(func())
+	if !ok {
+		return w.misuse("PanicsWithValue called against a non-func() ")
+	}
+
+	return w.result(PanicsWithValue(w.t, expected, value, msgAndArgs...), msgAndArgs...)
+}
+
+// Positive asserts that the 'actual' value is greater than zero.
+//
+//    assert(1).Positive()
+//
+// Returns whether the assertion was successful (true) or not (false).
+func (w *Wrapped) Positive(msgAndArgs ...interface{}) bool {
+	if h, ok := w.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	return w.result(Positive(w.t, w.actual, msgAndArgs...), msgAndArgs...)
 }
 
 // Regexp asserts that a specified regexp matches a string.
@@ -265,7 +1207,26 @@ func (w *Wrapped) Panics(msgAndArgs ...interface{}) bool {
 //
 // Returns whether the assertion was successful (true) or not (false).
 func (w *Wrapped) Regexp(regex interface{}, msgAndArgs ...interface{}) bool {
-	return Regexp(w.t, regex, w.actual, msgAndArgs...)
+	if h, ok := w.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	return w.result(Regexp(w.t, regex, w.actual, msgAndArgs...), msgAndArgs...)
+}
+
+// Subset asserts that the 'actual' list or map contains all of the elements or
+// entries of subset.
+//
+//    assert([]int{1, 2, 3}).Subset([]int{1, 3})
+//    assert(map[string]int{"a": 1, "b": 2}).Subset(map[string]int{"a": 1})
+//
+// Returns whether the assertion was successful (true) or not (false).
+func (w *Wrapped) Subset(subset interface{}, msgAndArgs ...interface{}) bool {
+	if h, ok := w.t.(tHelper); ok {
+		h.Helper()
+	}
+
+	return w.result(Subset(w.t, w.actual, subset, msgAndArgs...), msgAndArgs...)
 }
 
 // True asserts that the specified value is true.
@@ -274,12 +1235,16 @@ func (w *Wrapped) Regexp(regex interface{}, msgAndArgs ...interface{}) bool {
 //
 // Returns whether the assertion was successful (true) or not (false).
 func (w *Wrapped) True(msgAndArgs ...interface{}) bool {
+	if h, ok := w.t.(tHelper); ok {
+		h.Helper()
+	}
+
 	value, ok := w.actual.(bool)
 	if !ok {
-		return Fail(w.t, "True called against a non-bool")
+		return w.misuse("True called against a non-bool")
 	}
 
-	return True(w.t, value, msgAndArgs...)
+	return w.result(True(w.t, value, msgAndArgs...), msgAndArgs...)
 }
 
 // WithinDuration asserts that the two times are within duration delta of each other.
@@ -288,10 +1253,27 @@ func (w *Wrapped) True(msgAndArgs ...interface{}) bool {
 //
 // Returns whether the assertion was successful (true) or not (false).
 func (w *Wrapped) WithinDuration(expected time.Time, delta time.Duration, msgAndArgs ...interface{}) bool {
+	if h, ok := w.t.(tHelper); ok {
+		h.Helper()
+	}
+
 	value, ok := w.actual.(time.Time)
 	if !ok {
-		return Fail(w.t, "WithinDuration called against a non-time.Time")
+		return w.misuse("WithinDuration called against a non-time.Time")
+	}
+
+	return w.result(WithinDuration(w.t, expected, value, delta, msgAndArgs...), msgAndArgs...)
+}
+
+// Zero asserts that the 'actual' value is zero.
+//
+//    assert(balance.Sub(credits)).Zero()
+//
+// Returns whether the assertion was successful (true) or not (false).
+func (w *Wrapped) Zero(msgAndArgs ...interface{}) bool {
+	if h, ok := w.t.(tHelper); ok {
+		h.Helper()
 	}
 
-	return WithinDuration(w.t, expected, value, delta, msgAndArgs...)
+	return w.result(Zero(w.t, w.actual, msgAndArgs...), msgAndArgs...)
 }
@@ -2,7 +2,11 @@ package assert
 
 import (
 	"errors"
+	"fmt"
+	"io"
+	"io/fs"
 	"regexp"
+	"strings"
 	"testing"
 	"time"
 )
@@ -18,6 +22,50 @@ func TestTestingStuff(t *testing.T) {
 	assert(1).Must.Equal(3)
 }
 
+// failNowT is a bufferT that records whether FailNow was called.
+type failNowT struct {
+	bufferT
+	failedNow bool
+}
+
+func (t *failNowT) FailNow() {
+	t.failedNow = true
+}
+
+func TestWrappedMust(t *testing.T) {
+	mockT := new(failNowT)
+	assert := Wrap(mockT)
+
+	assert(1).Equal(2)
+	if mockT.failedNow {
+		t.Error("Equal should not call FailNow")
+	}
+
+	assert(1).Must.Equal(1)
+	if mockT.failedNow {
+		t.Error("Must.Equal should not call FailNow when successful")
+	}
+
+	assert(1).Must.Equal(2)
+	if !mockT.failedNow {
+		t.Error("Must.Equal should call FailNow when unsuccessful")
+	}
+
+	bufT := new(bufferT)
+	if Wrap(bufT)(1).Must.Equal(2) {
+		t.Error("Must.Equal should return false")
+	}
+	Contains(t, bufT.buf.String(), "Not equal")
+}
+
+func TestWrapBenchmark(t *testing.T) {
+	assert := Wrap(new(testing.B))
+
+	if !assert(1).Equal(1) {
+		t.Error("Equal should return true")
+	}
+}
+
 func TestWrappedImplements(t *testing.T) {
 	assert := Wrap(new(testing.T))
 
@@ -60,6 +108,21 @@ func TestWrappedEqual(t *testing.T) {
 	}
 }
 
+func TestWrappedEqualWith(t *testing.T) {
+	assert := Wrap(new(testing.T))
+
+	if !assert([]int(nil)).EqualWith([]int{}, EquateEmpty()) {
+		t.Error("EqualWith should return true")
+	}
+	if assert([]int(nil)).EqualWith([]int{}) {
+		t.Error("EqualWith should return false")
+	}
+
+	bufT := new(helperT)
+	Wrap(bufT)([]int(nil)).Not().EqualWith([]int{}, EquateEmpty(), "empty")
+	Contains(t, bufT.buf.String(), "Messages:\tempty\n")
+}
+
 func TestWrappedEquivalent(t *testing.T) {
 	assert := Wrap(new(testing.T))
 
@@ -417,3 +480,471 @@ func TestWrappedRegexp(t *testing.T) {
 		True(t, assert(tc.str).NotRegexp(regexp.MustCompile(tc.rx)))
 	}
 }
+
+func TestWrappedCondition(t *testing.T) {
+	assert := Wrap(new(testing.T))
+
+	if !assert(func() bool { return true }).Condition() {
+		t.Error("Condition should return true")
+	}
+	if !assert(Comparison(func() bool { return true })).Condition() {
+		t.Error("Condition should return true")
+	}
+	if assert(func() bool { return false }).Condition() {
+		t.Error("Condition should return false")
+	}
+	if assert(true).Condition() {
+		t.Error("Condition should return false for a non-Comparison")
+	}
+}
+
+func TestWrappedPolling(t *testing.T) {
+	assert := Wrap(new(testing.T))
+
+	calls := 0
+	if !assert(func() bool { calls++; return calls == 2 }).Eventually(time.Second) {
+		t.Error("Eventually should return true")
+	}
+	if assert(func() bool { return true }).Never(time.Second) {
+		t.Error("Never should return false")
+	}
+	if !assert(func() bool { return true }).Consistently(20 * time.Millisecond) {
+		t.Error("Consistently should return true")
+	}
+	if !assert(func(c *CollectT) { True(c, true) }).EventuallyWithT(time.Second) {
+		t.Error("EventuallyWithT should return true")
+	}
+	if assert(1).Eventually(time.Second) {
+		t.Error("Eventually should return false for a non-Comparison")
+	}
+}
+
+func TestWrappedError(t *testing.T) {
+	assert := Wrap(new(testing.T))
+	wrapped := fmt.Errorf("wrapped: %w", io.EOF)
+
+	if !assert(wrapped).Error() || assert(nil).Error() {
+		t.Error("Error should only return true for non-nil errors")
+	}
+	if !assert(nil).NoError() || assert(wrapped).NoError() {
+		t.Error("NoError should only return true for nil errors")
+	}
+	if !assert(wrapped).ErrorIs(io.EOF) || assert(wrapped).ErrorIs(io.ErrUnexpectedEOF) {
+		t.Error("ErrorIs should only return true when target is in the chain")
+	}
+	if !assert(wrapped).NotErrorIs(io.ErrUnexpectedEOF) || assert(wrapped).NotErrorIs(io.EOF) {
+		t.Error("NotErrorIs should only return true when target is not in the chain")
+	}
+	var pathErr *fs.PathError
+	if assert(wrapped).ErrorAs(&pathErr) {
+		t.Error("ErrorAs should return false")
+	}
+	if !assert(wrapped).EqualError("wrapped: EOF") || assert(wrapped).EqualError("EOF") {
+		t.Error("EqualError should only return true for equal messages")
+	}
+	if !assert(wrapped).ErrorContains("EOF") || assert(wrapped).ErrorContains("nope") {
+		t.Error("ErrorContains should only return true when the message contains the string")
+	}
+	if assert("not an error").NoError() {
+		t.Error("NoError should return false for a non-error")
+	}
+}
+
+func TestWrappedPanicsWithValue(t *testing.T) {
+	assert := Wrap(new(testing.T))
+
+	if !assert(func() { panic("Panic!") }).PanicsWithValue("Panic!") {
+		t.Error("PanicsWithValue should return true")
+	}
+	if assert(func() { panic("Other!") }).PanicsWithValue("Panic!") {
+		t.Error("PanicsWithValue should return false")
+	}
+	if !assert(func() { panic(io.EOF) }).PanicsWithError(io.EOF) {
+		t.Error("PanicsWithError should return true")
+	}
+	if assert(func() {}).PanicsWithError("EOF") {
+		t.Error("PanicsWithError should return false")
+	}
+	if !assert(func() { panic("Panic!") }).PanicsMatching("^Pan") {
+		t.Error("PanicsMatching should return true")
+	}
+	if assert("not a func").PanicsMatching("^Pan") {
+		t.Error("PanicsMatching should return false for a non-func")
+	}
+}
+
+func TestWrappedElementsMatch(t *testing.T) {
+	assert := Wrap(new(testing.T))
+
+	if !assert([]string{"b", "a"}).ElementsMatch([]string{"a", "b"}) {
+		t.Error("ElementsMatch should return true")
+	}
+	if assert([]string{"b", "a"}).ElementsMatch([]string{"a", "b", "b"}) {
+		t.Error("ElementsMatch should return false")
+	}
+}
+
+func TestWrappedSubset(t *testing.T) {
+	assert := Wrap(new(testing.T))
+
+	if !assert([]int{1, 2}).Subset([]int{2}) || assert([]int{1, 2}).Subset([]int{3}) {
+		t.Error("Subset should only return true for a subset")
+	}
+	if !assert([]int{1, 2}).NotSubset([]int{3}) || assert([]int{1, 2}).NotSubset([]int{2}) {
+		t.Error("NotSubset should only return true when not a subset")
+	}
+}
+
+func TestWrappedHasKey(t *testing.T) {
+	assert := Wrap(new(testing.T))
+	m := map[string]int{"a": 1}
+
+	if !assert(m).HasKey("a") || assert(m).HasKey("b") {
+		t.Error("HasKey should only return true for a key in the map")
+	}
+	if !assert(m).NotHasKey("b") || assert(m).NotHasKey("a") {
+		t.Error("NotHasKey should only return true for a key not in the map")
+	}
+	if !assert(m).HasEntry("a", 1) || assert(m).HasEntry("a", 2) {
+		t.Error("HasEntry should only return true for an entry in the map")
+	}
+}
+
+func TestWrappedOrdering(t *testing.T) {
+	assert := Wrap(new(testing.T))
+
+	if !assert(2).Greater(1) || assert(1).Greater(2) {
+		t.Error("Greater should only return true when actual > e2")
+	}
+	if !assert(2).GreaterOrEqual(2) || assert(1).GreaterOrEqual(2) {
+		t.Error("GreaterOrEqual should only return true when actual >= e2")
+	}
+	if !assert("a").Less("b") || assert("b").Less("a") {
+		t.Error("Less should only return true when actual < e2")
+	}
+	if !assert(time.Second).LessOrEqual(time.Second) || assert(time.Minute).LessOrEqual(time.Second) {
+		t.Error("LessOrEqual should only return true when actual <= e2")
+	}
+	if !assert(time.Second).Positive() || assert(-time.Second).Positive() {
+		t.Error("Positive should only return true when actual > 0")
+	}
+	if !assert(-1.5).Negative() || assert(1.5).Negative() {
+		t.Error("Negative should only return true when actual < 0")
+	}
+	if !assert(time.Duration(0)).Zero() || assert(time.Second).Zero() {
+		t.Error("Zero should only return true when actual == 0")
+	}
+	if !assert(uint(1)).NotZero() || assert(uint(0)).NotZero() {
+		t.Error("NotZero should only return true when actual != 0")
+	}
+	if !assert("").Zero() || assert(time.Now()).Zero() {
+		t.Error("Zero should only return true when actual is the zero value")
+	}
+}
+
+func TestWrappedSequenceOrdering(t *testing.T) {
+	assert := Wrap(new(testing.T))
+
+	if !assert([]int{1, 1, 2}).IsSorted() || assert([]int{2, 1}).IsSorted() {
+		t.Error("IsSorted should only return true for a sorted list")
+	}
+	if !assert([]int{2, 1}).IsSortedBy(func(a, b int) bool { return a > b }) {
+		t.Error("IsSortedBy should return true for a list sorted by less")
+	}
+	if !assert([]int{1, 2}).IsIncreasing() || assert([]int{1, 1}).IsIncreasing() {
+		t.Error("IsIncreasing should only return true for an increasing list")
+	}
+	if !assert([]int{1, 1}).IsNonDecreasing() || assert([]int{2, 1}).IsNonDecreasing() {
+		t.Error("IsNonDecreasing should only return true for a non-decreasing list")
+	}
+	if !assert([]int{2, 1}).IsDecreasing() || assert([]int{1, 1}).IsDecreasing() {
+		t.Error("IsDecreasing should only return true for a decreasing list")
+	}
+}
+
+func TestWrappedMatches(t *testing.T) {
+	assert := Wrap(new(testing.T))
+
+	if !assert([]int{1, 2}).Matches(AllOf(HasLen(2), Not(Each(1)))) {
+		t.Error("Matches should return true")
+	}
+	if assert("abc").Matches(HasLen(2)) {
+		t.Error("Matches should return false")
+	}
+}
+
+type wrappedResponse struct {
+	Status  int
+	Body    *wrappedBody
+	Items   []wrappedBody
+	Headers map[string]string
+	secret  string
+}
+
+func (r wrappedResponse) Header(key string) string {
+	return r.Headers[key]
+}
+
+func (r *wrappedResponse) Item(i int) (wrappedBody, error) {
+	if i >= len(r.Items) {
+		return wrappedBody{}, io.EOF
+	}
+
+	return r.Items[i], nil
+}
+
+func (r wrappedResponse) Names(prefix string, suffixes ...string) []string {
+	names := make([]string, len(suffixes))
+	for i, suffix := range suffixes {
+		names[i] = prefix + suffix
+	}
+
+	return names
+}
+
+type wrappedBody struct {
+	Name string
+}
+
+func closedChan() chan int {
+	ch := make(chan int, 1)
+	ch <- 1
+	close(ch)
+	return ch
+}
+
+func TestWrappedNot(t *testing.T) {
+	bufT := new(helperT)
+	assert := Wrap(bufT)
+
+	if !assert("it's starting").Not().Regexp("^it's not") {
+		t.Error("Not().Regexp should return true when Regexp fails")
+	}
+	if !assert(1).Not().Not().Equal(1) {
+		t.Error("Not().Not().Equal should return true when Equal passes")
+	}
+	Equal(t, "", bufT.buf.String())
+
+	if assert(1).Not().Equal(1, "checking %s", "one") {
+		t.Error("Not().Equal should return false when Equal passes")
+	}
+	Contains(t, bufT.buf.String(), "Error:\t\tNot().Equal passed for 1, but should have failed")
+	Contains(t, bufT.buf.String(), "Messages:\tchecking one")
+
+	bufT = new(helperT)
+	if Wrap(bufT)(1).Not().True() {
+		t.Error("Not().True should return false when misused")
+	}
+	Contains(t, bufT.buf.String(), "True called against a non-bool")
+
+	misused := map[string]struct {
+		check    func(w *Wrapped) bool
+		actual   interface{}
+		expected string
+	}{
+		"Greater":        {func(w *Wrapped) bool { return w.Greater("a") }, 1, "Cannot compare"},
+		"Contains":       {func(w *Wrapped) bool { return w.Contains(1) }, 42, "42 is not a string, array, slice, map, channel or fmt.Stringer"},
+		"ElementsMatch":  {func(w *Wrapped) bool { return w.ElementsMatch([]int{1}) }, 42, "Parameters must be array or slice, got []int and int"},
+		"HasKey":         {func(w *Wrapped) bool { return w.HasKey("a") }, 1, "1 is not a map"},
+		"Positive":       {func(w *Wrapped) bool { return w.Positive() }, "a", "Cannot check the sign of"},
+		"IsSorted":       {func(w *Wrapped) bool { return w.IsSorted() }, 1, "1 is not an array or slice"},
+		"Subset":         {func(w *Wrapped) bool { return w.Subset(1) }, []int{1}, "Parameters must both be arrays or slices"},
+		"Len":            {func(w *Wrapped) bool { return w.Len(1) }, 1, "could not be applied builtin len()"},
+		"Closed channel": {func(w *Wrapped) bool { return w.NotContains(1) }, closedChan(), "is closed"},
+	}
+
+	for name, c := range misused {
+		bufT := new(helperT)
+		w := Wrap(bufT)(c.actual).Not()
+		if c.check(w) {
+			t.Errorf("%s: Not() should return false when misused", name)
+		}
+		Contains(t, bufT.buf.String(), c.expected, name)
+		Equal(t, 1, strings.Count(bufT.buf.String(), "Error Trace:"), name)
+		NotContains(t, bufT.buf.String(), "but should have failed", name)
+
+		if !w.Nil() {
+			t.Errorf("%s: the next assertion should not be failed by misuse", name)
+		}
+	}
+
+	mockT := new(failNowT)
+	Wrap(mockT)(1).Must.Not().Greater("a")
+	if !mockT.failedNow {
+		t.Error("Must.Not().Greater should call FailNow when misused")
+	}
+
+	mockT = new(failNowT)
+	Wrap(mockT)(1).Must.Not().Equal(2)
+	if mockT.failedNow {
+		t.Error("Must.Not().Equal should not call FailNow when successful")
+	}
+	Wrap(mockT)(1).Must.Not().Equal(1)
+	if !mockT.failedNow {
+		t.Error("Must.Not().Equal should call FailNow when unsuccessful")
+	}
+}
+
+func TestWrappedField(t *testing.T) {
+	resp := &wrappedResponse{Status: 200, Body: &wrappedBody{Name: "John"}}
+	bufT := new(helperT)
+	assert := Wrap(bufT)
+
+	if !assert(resp).Field("Status").Equal(200) {
+		t.Error("Field should return assertions against the field")
+	}
+	if !assert(*resp).Field("Body").Field("Name").Equal("John") {
+		t.Error("Field should navigate through pointers")
+	}
+	Equal(t, "", bufT.buf.String())
+
+	assert(resp).Field("Body").Field("Name").Equal("Jane")
+	Contains(t, bufT.buf.String(), "Path:\t\t.Body.Name")
+
+	cases := map[string]struct {
+		actual   interface{}
+		name     string
+		expected string
+	}{
+		"not a struct": {1, "Status", "Field Status called against 1, which is not a struct"},
+		"nil pointer":  {(*wrappedResponse)(nil), "Status", "Field Status called against a nil *assert.wrappedResponse"},
+		"missing":      {resp, "Missing", "*assert.wrappedResponse has no field Missing"},
+		"unexported":   {resp, "secret", "Field secret of *assert.wrappedResponse is unexported"},
+	}
+
+	for name, c := range cases {
+		bufT := new(helperT)
+		if Wrap(bufT)(c.actual).Field(c.name).Not().Nil() {
+			t.Errorf("%s: assertions after a failed Field should return false", name)
+		}
+		Contains(t, bufT.buf.String(), c.expected, name)
+		Equal(t, 1, strings.Count(bufT.buf.String(), "Error Trace:"), name)
+	}
+
+	mockT := new(failNowT)
+	Wrap(mockT)(resp).Must.Field("Missing")
+	if !mockT.failedNow {
+		t.Error("Must.Field should call FailNow when unsuccessful")
+	}
+}
+
+func TestWrappedIndexAndKey(t *testing.T) {
+	resp := &wrappedResponse{
+		Items:   []wrappedBody{{Name: "John"}, {Name: "Jane"}},
+		Headers: map[string]string{"Accept": "application/json"},
+	}
+	bufT := new(helperT)
+	assert := Wrap(bufT)
+
+	if !assert(resp).Field("Items").Index(1).Field("Name").Equal("Jane") {
+		t.Error("Index should return assertions against the element")
+	}
+	if !assert("abc").Index(2).Equal(byte('c')) || !assert(&[2]int{1, 2}).Index(0).Equal(1) {
+		t.Error("Index should work with strings and pointers to arrays")
+	}
+	if !assert(resp).Field("Headers").Key("Accept").Equal("application/json") {
+		t.Error("Key should return assertions against the value")
+	}
+	Equal(t, "", bufT.buf.String())
+
+	assert(resp).Field("Items").Index(0).Field("Name").Equal("Jane")
+	Contains(t, bufT.buf.String(), "Path:\t\t.Items[0].Name")
+
+	bufT = new(helperT)
+	Wrap(bufT)(resp).Field("Headers").Key("Accept").Equal("text/plain")
+	Contains(t, bufT.buf.String(), "Path:\t\t.Headers[\"Accept\"]")
+
+	failures := map[string]struct {
+		navigate func(w *Wrapped) *Wrapped
+		expected string
+	}{
+		"index out of range": {func(w *Wrapped) *Wrapped { return w.Field("Items").Index(2) }, "Index 2 out of range for []assert.wrappedBody with length 2"},
+		"index nil":          {func(w *Wrapped) *Wrapped { return w.Field("Body").Index(0) }, "Index 0 called against a nil *assert.wrappedBody"},
+		"index not a list":   {func(w *Wrapped) *Wrapped { return w.Field("Status").Index(0) }, "Index 0 called against 0, which is not an array, slice or string"},
+		"key missing":        {func(w *Wrapped) *Wrapped { return w.Field("Headers").Key("Host") }, `map[string]string has no key "Host"`},
+		"key not a map":      {func(w *Wrapped) *Wrapped { return w.Key("Host") }, `Key "Host" called against &assert.wrappedResponse{`},
+	}
+
+	for name, c := range failures {
+		bufT := new(helperT)
+		if c.navigate(&Wrap(bufT)(resp).Wrapped).NotNil() {
+			t.Errorf("%s: assertions after failed navigation should return false", name)
+		}
+		Contains(t, bufT.buf.String(), c.expected, name)
+		Equal(t, 1, strings.Count(bufT.buf.String(), "Error Trace:"), name)
+	}
+
+	bufT = new(helperT)
+	Wrap(bufT)(map[interface{}]int{1: 1}).Key([]int{1})
+	Contains(t, bufT.buf.String(), "map[interface {}]int has no key []int{1}")
+}
+
+func TestWrappedDerefAndCall(t *testing.T) {
+	resp := &wrappedResponse{
+		Body:    &wrappedBody{Name: "John"},
+		Items:   []wrappedBody{{Name: "John"}},
+		Headers: map[string]string{"Accept": "application/json"},
+	}
+	bufT := new(helperT)
+	assert := Wrap(bufT)
+
+	if !assert(resp).Field("Body").Deref().Equal(wrappedBody{Name: "John"}) {
+		t.Error("Deref should return assertions against the value pointed to")
+	}
+	if !assert(resp).Call("Header", "Accept").Equal("application/json") {
+		t.Error("Call should return assertions against the result")
+	}
+	if !assert(*resp).Call("Item", 0).Field("Name").Equal("John") {
+		t.Error("Call should call pointer methods against a copy of a value")
+	}
+	if !assert(resp).Call("Names", "a", "b", "c").Equal([]string{"ab", "ac"}) {
+		t.Error("Call should call variadic methods")
+	}
+	Equal(t, "", bufT.buf.String())
+
+	assert(resp).Call("Item", 0).Field("Name").Equal("Jane")
+	Contains(t, bufT.buf.String(), "Path:\t\t.Item(0).Name")
+
+	failures := map[string]struct {
+		navigate func(w *Wrapped) *Wrapped
+		expected string
+	}{
+		"deref not pointer": {func(w *Wrapped) *Wrapped { return w.Field("Status").Deref() }, "Deref called against 0, which is not a pointer"},
+		"call missing":      {func(w *Wrapped) *Wrapped { return w.Call("Missing") }, "*assert.wrappedResponse has no method Missing"},
+		"call arguments":    {func(w *Wrapped) *Wrapped { return w.Call("Header") }, "Cannot call Header: called with 0 arguments, but it takes 1"},
+		"call argument":     {func(w *Wrapped) *Wrapped { return w.Call("Header", 1) }, "Cannot call Header: argument 1 is int, but should be string"},
+		"call error":        {func(w *Wrapped) *Wrapped { return w.Call("Item", 1) }, "Item returned error: EOF"},
+	}
+
+	for name, c := range failures {
+		bufT := new(helperT)
+		if c.navigate(&Wrap(bufT)(resp).Wrapped).NotNil() {
+			t.Errorf("%s: assertions after failed navigation should return false", name)
+		}
+		Contains(t, bufT.buf.String(), c.expected, name)
+		Equal(t, 1, strings.Count(bufT.buf.String(), "Error Trace:"), name)
+	}
+
+	bufT = new(helperT)
+	Wrap(bufT)((*wrappedBody)(nil)).Deref()
+	Contains(t, bufT.buf.String(), "Deref called against a nil *assert.wrappedBody")
+}
+
+func TestWrappedWithContext(t *testing.T) {
+	mockT := new(failNowT)
+	assert := Wrap(mockT).WithContext("case %d", 1)
+
+	assert(1).Equal(2)
+	Contains(t, mockT.buf.String(), "Messages:\tcase 1\n")
+	if mockT.failedNow {
+		t.Error("Equal should not call FailNow")
+	}
+
+	mockT.buf.Reset()
+	assert = assert.WithContext("step %s", "login")
+	assert(&wrappedResponse{}).Must.Field("Status").Equal(200, "status")
+	Contains(t, mockT.buf.String(), "Messages:\tcase 1: step login: status\n")
+	if !mockT.failedNow {
+		t.Error("Must.Equal should call FailNow")
+	}
+}